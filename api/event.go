@@ -2,12 +2,14 @@ package api
 
 import (
 	"database/sql"
+	"errors"
 	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/yashagw/event-management-api/db/model"
 	"github.com/yashagw/event-management-api/token"
+	"github.com/yashagw/event-management-api/worker"
 )
 
 type CreateEventParams struct {
@@ -17,6 +19,8 @@ type CreateEventParams struct {
 	TotalTickets int64     `json:"total_tickets"`
 	StartDate    time.Time `json:"start_date"`
 	EndDate      time.Time `json:"end_date"`
+	// Tickets can be cancelled until this many hours before the start
+	CancellationCutoffHours int64 `json:"cancellation_cutoff_hours" binding:"min=0"`
 }
 
 // CreateEvent   godoc
@@ -26,47 +30,35 @@ type CreateEventParams struct {
 // @Produce      json
 // @Param        event body CreateEventParams true "Event"
 // @Success      201 {object} model.Event
+// @Failure      400 {object} ResponseMessage "Invalid event"
 // @Router       /hosts/events [post]
 // @Security     Bearer
 func (server *Server) CreateEvent(context *gin.Context) {
-	// Authorization to make sure payload have user role
 	payload := context.MustGet(authorizationPayloadKey).(*token.Payload)
-	userEmail := payload.Username
-
-	user, err := server.provider.GetUserByEmail(context, userEmail)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			context.JSON(http.StatusUnauthorized, gin.H{"message": "Not Authorized"})
-			return
-		}
-		context.JSON(http.StatusInternalServerError, errorResponse(err))
-		return
-	}
-
-	// Only host can create event
-	if user.Role != model.UserRole_Host {
-		context.JSON(http.StatusUnauthorized, gin.H{"message": "Not Authorized"})
-		return
-	}
 
 	var params CreateEventParams
 	if err := context.ShouldBindJSON(&params); err != nil {
 		context.JSON(http.StatusBadRequest, errorResponse(err))
 		return
 	}
-	// TODO: Validate params start date and end date are in the future
 
 	event, err := server.provider.CreateEvent(context, model.CreateEventParams{
-		HostID:       user.ID,
+		HostID:       payload.UserID,
 		Name:         params.Name,
 		Description:  params.Description,
 		Location:     params.Location,
 		TotalTickets: params.TotalTickets,
 		StartDate:    params.StartDate,
 		EndDate:      params.EndDate,
+
+		CancellationCutoffHours: params.CancellationCutoffHours,
 	})
 
 	if err != nil {
+		if errors.Is(err, model.ErrInvalidEventDates) {
+			context.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
 		context.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
@@ -74,15 +66,45 @@ func (server *Server) CreateEvent(context *gin.Context) {
 	context.JSON(http.StatusCreated, event)
 }
 
+// EventFiltersParams are the query parameters that narrow down a list of events
+type EventFiltersParams struct {
+	Period         string    `form:"period" binding:"omitempty,oneof=ongoing upcoming past"`
+	DurationDays   int       `form:"duration_days" binding:"min=0"`
+	Month          time.Time `form:"month" time_format:"2006-01" time_utc:"1"`
+	City           string    `form:"city"`
+	MinTicketsLeft int64     `form:"min_tickets_left" binding:"min=0"`
+}
+
+func (params EventFiltersParams) toModel() model.EventFilters {
+	return model.EventFilters{
+		Period:         model.EventPeriod(params.Period),
+		DurationDays:   params.DurationDays,
+		Month:          params.Month,
+		City:           params.City,
+		MinTicketsLeft: params.MinTicketsLeft,
+	}
+}
+
 type ListEventsParams struct {
 	Limit  int `form:"limit" binding:"required,min=1,max=1000"`
 	Offset int `form:"offset"`
+	EventFiltersParams
 }
 
 // ListEvents   godoc
 // @Summary      Lists all events.
-// @Description  Lists all events.
+// @Description  Lists all published, ongoing, completed and cancelled events, drafts are hidden.
 // @Produce      json
+// @Param        limit query int true "Limit"
+// @Param        offset query int false "Offset"
+// @Param        period query string false "Period" Enums(ongoing, upcoming, past)
+// @Param        duration_days query int false "Number of calendar days the event spans"
+// @Param        month query string false "Month the event takes place in, as YYYY-MM"
+// @Param        city query string false "City"
+// @Param        min_tickets_left query int false "Minimum tickets left"
+// @Success      200 {object} model.ListEventsResponse
+// @Failure      400 {object} ResponseMessage "Invalid filters"
+// @Router       /events [get]
 func (server *Server) ListEvents(context *gin.Context) {
 	var params ListEventsParams
 	if err := context.ShouldBindQuery(&params); err != nil {
@@ -91,8 +113,10 @@ func (server *Server) ListEvents(context *gin.Context) {
 	}
 
 	events, err := server.provider.ListEvents(context, model.ListEventsParams{
-		Limit:  params.Limit,
-		Offset: params.Offset,
+		Published:    true,
+		EventFilters: params.toModel(),
+		Limit:        params.Limit,
+		Offset:       params.Offset,
 	})
 	if err != nil {
 		context.JSON(http.StatusInternalServerError, errorResponse(err))
@@ -105,48 +129,124 @@ func (server *Server) ListEvents(context *gin.Context) {
 type ListHostEventsParams struct {
 	Limit  int `form:"limit" binding:"required,min=1,max=1000"`
 	Offset int `form:"offset"`
+	EventFiltersParams
 }
 
 // ListHostEvents   godoc
 // @Summary      Lists events created by the host.
-// @Description  Lists events created by the host.
+// @Description  Lists events created by the host, drafts included.
 // @Tags         host
 // @Produce      json
 // @Param        limit query int true "Limit"
 // @Param        offset query int false "Offset"
+// @Param        period query string false "Period" Enums(ongoing, upcoming, past)
+// @Param        duration_days query int false "Number of calendar days the event spans"
+// @Param        month query string false "Month the event takes place in, as YYYY-MM"
+// @Param        city query string false "City"
+// @Param        min_tickets_left query int false "Minimum tickets left"
 // @Success      200 {object} model.ListEventsResponse
 // @Router       /hosts/events [get]
 // @Security     Bearer
 func (server *Server) ListHostEvents(context *gin.Context) {
 	payload := context.MustGet(authorizationPayloadKey).(*token.Payload)
-	userEmail := payload.Username
 
-	user, err := server.provider.GetUserByEmail(context, userEmail)
+	var params ListHostEventsParams
+	if err := context.ShouldBindQuery(&params); err != nil {
+		context.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	events, err := server.provider.ListEvents(context, model.ListEventsParams{
+		HostID:       payload.UserID,
+		EventFilters: params.toModel(),
+		Limit:        params.Limit,
+		Offset:       params.Offset,
+	})
 	if err != nil {
-		if err == sql.ErrNoRows {
-			context.JSON(http.StatusUnauthorized, gin.H{"message": "Not Authorized"})
-			return
-		}
 		context.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
-	// Only host can list events
-	if user.Role != model.UserRole_Host {
-		context.JSON(http.StatusUnauthorized, gin.H{"message": "Not Authorized"})
+	context.JSON(http.StatusOK, events)
+}
+
+type SearchEventsParams struct {
+	Query  string `form:"q" binding:"required"`
+	Limit  int    `form:"limit" binding:"required,min=1,max=1000"`
+	Offset int    `form:"offset" binding:"min=0"`
+	EventFiltersParams
+}
+
+// SearchEvents   godoc
+// @Summary      Searches events.
+// @Description  Searches the name, location and description of the events that are not drafts, best matches first. Every word has to match, as a whole word or the start of one. Matches are wrapped in <b></b> in the headline.
+// @Produce      json
+// @Param        q query string true "Search text"
+// @Param        limit query int true "Limit"
+// @Param        offset query int false "Offset"
+// @Param        period query string false "Period" Enums(ongoing, upcoming, past)
+// @Param        duration_days query int false "Number of calendar days the event spans"
+// @Param        month query string false "Month the event takes place in, as YYYY-MM"
+// @Param        city query string false "City"
+// @Param        min_tickets_left query int false "Minimum tickets left"
+// @Success      200 {object} model.SearchEventsResponse
+// @Failure      400 {object} ResponseMessage "Invalid search"
+// @Router       /events/search [get]
+func (server *Server) SearchEvents(context *gin.Context) {
+	var params SearchEventsParams
+	if err := context.ShouldBindQuery(&params); err != nil {
+		context.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	events, err := server.provider.SearchEvents(context, model.SearchEventsParams{
+		Query:        params.Query,
+		Published:    true,
+		EventFilters: params.toModel(),
+		Limit:        params.Limit,
+		Offset:       params.Offset,
+	})
+	if err != nil {
+		context.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
-	var params ListHostEventsParams
+	context.JSON(http.StatusOK, events)
+}
+
+// SearchHostEvents   godoc
+// @Summary      Searches events created by the host.
+// @Description  Searches the name, location and description of the events of the host, drafts included, best matches first.
+// @Tags         host
+// @Produce      json
+// @Param        q query string true "Search text"
+// @Param        limit query int true "Limit"
+// @Param        offset query int false "Offset"
+// @Param        period query string false "Period" Enums(ongoing, upcoming, past)
+// @Param        duration_days query int false "Number of calendar days the event spans"
+// @Param        month query string false "Month the event takes place in, as YYYY-MM"
+// @Param        city query string false "City"
+// @Param        min_tickets_left query int false "Minimum tickets left"
+// @Success      200 {object} model.SearchEventsResponse
+// @Failure      400 {object} ResponseMessage "Invalid search"
+// @Failure      401 {object} ResponseMessage "Not Authorized"
+// @Router       /hosts/events/search [get]
+// @Security     Bearer
+func (server *Server) SearchHostEvents(context *gin.Context) {
+	payload := context.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	var params SearchEventsParams
 	if err := context.ShouldBindQuery(&params); err != nil {
 		context.JSON(http.StatusBadRequest, errorResponse(err))
 		return
 	}
 
-	events, err := server.provider.ListEvents(context, model.ListEventsParams{
-		HostID: user.ID,
-		Limit:  params.Limit,
-		Offset: params.Offset,
+	events, err := server.provider.SearchEvents(context, model.SearchEventsParams{
+		Query:        params.Query,
+		HostID:       payload.UserID,
+		EventFilters: params.toModel(),
+		Limit:        params.Limit,
+		Offset:       params.Offset,
 	})
 	if err != nil {
 		context.JSON(http.StatusInternalServerError, errorResponse(err))
@@ -156,13 +256,212 @@ func (server *Server) ListHostEvents(context *gin.Context) {
 	context.JSON(http.StatusOK, events)
 }
 
+type UpdateEventUri struct {
+	EventID int64 `uri:"event_id" binding:"required,min=1"`
+}
+
+// UpdateEventParams holds the fields to change, fields that are left out are kept
+type UpdateEventParams struct {
+	Name         *string    `json:"name" binding:"omitempty,min=1"`
+	Description  *string    `json:"description"`
+	Location     *string    `json:"location" binding:"omitempty,min=1"`
+	TotalTickets *int64     `json:"total_tickets" binding:"omitempty,min=1"`
+	StartDate    *time.Time `json:"start_date"`
+	EndDate      *time.Time `json:"end_date"`
+}
+
+// UpdateEvent   godoc
+// @Summary      Updates an event.
+// @Description  Updates an event of the host. The description can be changed at any time, the other fields only while no tickets are sold. Ticket holders are told about the change by email.
+// @Tags         host
+// @Accept       json
+// @Produce      json
+// @Param        event_id path int true "Event ID"
+// @Param        event body UpdateEventParams true "Changes"
+// @Success      200 {object} model.Event
+// @Failure      400 {object} ResponseMessage "Invalid changes"
+// @Failure      401 {object} ResponseMessage "Not Authorized"
+// @Failure      403 {object} ResponseMessage "Event of another host"
+// @Failure      404 {object} ResponseMessage "Event not found"
+// @Failure      409 {object} ResponseMessage "Tickets sold, event cancelled or ended"
+// @Router       /hosts/events/{event_id} [patch]
+// @Security     Bearer
+func (server *Server) UpdateEvent(context *gin.Context) {
+	payload := context.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	var uri UpdateEventUri
+	if err := context.ShouldBindUri(&uri); err != nil {
+		context.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var params UpdateEventParams
+	if err := context.ShouldBindJSON(&params); err != nil {
+		context.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	event, err := server.provider.UpdateEventTx(context, model.UpdateEventTxParams{
+		UpdateEventParams: model.UpdateEventParams{
+			EventID:      uri.EventID,
+			HostID:       payload.UserID,
+			Name:         params.Name,
+			Description:  params.Description,
+			Location:     params.Location,
+			TotalTickets: params.TotalTickets,
+			StartDate:    params.StartDate,
+			EndDate:      params.EndDate,
+		},
+		AfterUpdate: worker.NewEventChangedMessages,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			context.JSON(http.StatusNotFound, errorResponse(err))
+		case errors.Is(err, model.ErrEventNotOwned):
+			context.JSON(http.StatusForbidden, errorResponse(err))
+		case errors.Is(err, model.ErrEventTicketsSold), errors.Is(err, model.ErrEventCancelled), errors.Is(err, model.ErrEventEnded):
+			context.JSON(http.StatusConflict, errorResponse(err))
+		case errors.Is(err, model.ErrInvalidEventDates):
+			context.JSON(http.StatusBadRequest, errorResponse(err))
+		default:
+			context.JSON(http.StatusInternalServerError, errorResponse(err))
+		}
+		return
+	}
+
+	context.JSON(http.StatusOK, event)
+}
+
+type EventStatusUri struct {
+	EventID int64 `uri:"event_id" binding:"required,min=1"`
+}
+
+// PublishEvent   godoc
+// @Summary      Publishes an event.
+// @Description  Publishes a draft event of the host so that it is listed and tickets can be bought.
+// @Tags         host
+// @Produce      json
+// @Param        event_id path int true "Event ID"
+// @Success      200 {object} model.Event
+// @Failure      401 {object} ResponseMessage "Not Authorized"
+// @Failure      403 {object} ResponseMessage "Event of another host"
+// @Failure      404 {object} ResponseMessage "Event not found"
+// @Failure      409 {object} ResponseMessage "Event is not a draft"
+// @Router       /hosts/events/{event_id}/publish [post]
+// @Security     Bearer
+func (server *Server) PublishEvent(context *gin.Context) {
+	server.updateEventStatus(context, model.EventStatus_Published)
+}
+
+// UnpublishEvent   godoc
+// @Summary      Unpublishes an event.
+// @Description  Turns a published event of the host that has not started and has no tickets sold back into a draft.
+// @Tags         host
+// @Produce      json
+// @Param        event_id path int true "Event ID"
+// @Success      200 {object} model.Event
+// @Failure      401 {object} ResponseMessage "Not Authorized"
+// @Failure      403 {object} ResponseMessage "Event of another host"
+// @Failure      404 {object} ResponseMessage "Event not found"
+// @Failure      409 {object} ResponseMessage "Event is not published or tickets sold"
+// @Router       /hosts/events/{event_id}/unpublish [post]
+// @Security     Bearer
+func (server *Server) UnpublishEvent(context *gin.Context) {
+	server.updateEventStatus(context, model.EventStatus_Draft)
+}
+
+func (server *Server) updateEventStatus(context *gin.Context, status model.EventStatus) {
+	payload := context.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	var uri EventStatusUri
+	if err := context.ShouldBindUri(&uri); err != nil {
+		context.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	event, err := server.provider.UpdateEventStatus(context, model.UpdateEventStatusParams{
+		EventID: uri.EventID,
+		HostID:  payload.UserID,
+		Status:  status,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			context.JSON(http.StatusNotFound, errorResponse(err))
+		case errors.Is(err, model.ErrEventNotOwned):
+			context.JSON(http.StatusForbidden, errorResponse(err))
+		case errors.Is(err, model.ErrInvalidStatusTransition),
+			errors.Is(err, model.ErrEventCancelled),
+			errors.Is(err, model.ErrEventEnded),
+			errors.Is(err, model.ErrEventTicketsSold):
+			context.JSON(http.StatusConflict, errorResponse(err))
+		default:
+			context.JSON(http.StatusInternalServerError, errorResponse(err))
+		}
+		return
+	}
+
+	context.JSON(http.StatusOK, event)
+}
+
+type CancelEventUri struct {
+	EventID int64 `uri:"event_id" binding:"required,min=1"`
+}
+
+// CancelEvent   godoc
+// @Summary      Cancels an event.
+// @Description  Cancels an event of the host and refunds all of its tickets. Ticket holders are told by email, the event stays readable with the cancelled status.
+// @Tags         host
+// @Produce      json
+// @Param        event_id path int true "Event ID"
+// @Success      200 {object} model.Event
+// @Failure      401 {object} ResponseMessage "Not Authorized"
+// @Failure      403 {object} ResponseMessage "Event of another host"
+// @Failure      404 {object} ResponseMessage "Event not found"
+// @Failure      409 {object} ResponseMessage "Event already cancelled or ended"
+// @Router       /hosts/events/{event_id}/cancel [post]
+// @Security     Bearer
+func (server *Server) CancelEvent(context *gin.Context) {
+	payload := context.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	var uri CancelEventUri
+	if err := context.ShouldBindUri(&uri); err != nil {
+		context.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	event, err := server.provider.CancelEventTx(context, model.CancelEventTxParams{
+		CancelEventParams: model.CancelEventParams{
+			EventID: uri.EventID,
+			HostID:  payload.UserID,
+		},
+		AfterCancel: worker.NewEventCancelledMessages,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			context.JSON(http.StatusNotFound, errorResponse(err))
+		case errors.Is(err, model.ErrEventNotOwned):
+			context.JSON(http.StatusForbidden, errorResponse(err))
+		case errors.Is(err, model.ErrEventCancelled), errors.Is(err, model.ErrEventEnded):
+			context.JSON(http.StatusConflict, errorResponse(err))
+		default:
+			context.JSON(http.StatusInternalServerError, errorResponse(err))
+		}
+		return
+	}
+
+	context.JSON(http.StatusOK, event)
+}
+
 type GetEventParams struct {
 	EventID int64 `uri:"event_id" binding:"required"`
 }
 
 // GetEvent   godoc
 // @Summary      Get event info
-// @Description  Get event info
+// @Description  Get event info, drafts are not found
 // @Produce      json
 // @Param        event_id path int true "Event ID"
 // @Success      200 {object} model.Event
@@ -175,12 +474,100 @@ func (server *Server) GetEvent(context *gin.Context) {
 	}
 
 	event, err := server.provider.GetEvent(context, model.GetEventParams{
-		EventID: params.EventID,
+		EventID:   params.EventID,
+		Published: true,
 	})
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			context.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
 		context.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
 	context.JSON(http.StatusOK, event)
 }
+
+type ListEventTicketsUri struct {
+	EventID int64 `uri:"event_id" binding:"required,min=1"`
+}
+
+type ListEventTicketsParams struct {
+	Limit  int `form:"limit" binding:"required,min=1,max=1000"`
+	Offset int `form:"offset" binding:"min=0"`
+}
+
+type ListEventTicketsResponse struct {
+	Records    []model.EventTicket   `json:"records"`
+	NextOffset int                   `json:"next_offset"`
+	Stats      model.EventSalesStats `json:"stats"`
+}
+
+// ListEventTickets   godoc
+// @Summary      Lists tickets sold for an event.
+// @Description  Lists the tickets sold for an event of the host with their buyers, together with the sales of the event.
+// @Tags         host
+// @Produce      json
+// @Param        event_id path int true "Event ID"
+// @Param        limit query int true "Limit"
+// @Param        offset query int false "Offset"
+// @Success      200 {object} ListEventTicketsResponse
+// @Failure      401 {object} ResponseMessage "Not Authorized"
+// @Failure      403 {object} ResponseMessage "Event of another host"
+// @Failure      404 {object} ResponseMessage "Event not found"
+// @Router       /hosts/events/{event_id}/tickets [get]
+// @Security     Bearer
+func (server *Server) ListEventTickets(context *gin.Context) {
+	payload := context.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	var uri ListEventTicketsUri
+	if err := context.ShouldBindUri(&uri); err != nil {
+		context.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var params ListEventTicketsParams
+	if err := context.ShouldBindQuery(&params); err != nil {
+		context.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	event, err := server.provider.GetEvent(context, model.GetEventParams{
+		EventID: uri.EventID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			context.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		context.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	if event.HostID != payload.UserID {
+		context.JSON(http.StatusForbidden, errorResponse(model.ErrEventNotOwned))
+		return
+	}
+
+	tickets, err := server.provider.ListEventTickets(context, model.ListEventTicketsParams{
+		EventID: event.ID,
+		Limit:   params.Limit,
+		Offset:  params.Offset,
+	})
+	if err != nil {
+		context.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	stats, err := server.provider.GetEventSalesStats(context, event.ID)
+	if err != nil {
+		context.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	context.JSON(http.StatusOK, ListEventTicketsResponse{
+		Records:    tickets.Records,
+		NextOffset: tickets.NextOffset,
+		Stats:      *stats,
+	})
+}
@@ -2,6 +2,8 @@ package api
 
 import (
 	"bytes"
+	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -15,9 +17,29 @@ import (
 	mockdb "github.com/yashagw/event-management-api/db/mock"
 	"github.com/yashagw/event-management-api/db/model"
 	"github.com/yashagw/event-management-api/token"
+	"github.com/yashagw/event-management-api/util"
+	"github.com/yashagw/event-management-api/worker"
 	mockwk "github.com/yashagw/event-management-api/worker/mock"
 )
 
+func randomEvent(t *testing.T, hostID int64) model.Event {
+	totalTickets := util.RandomInt(1, 100)
+	startDate := time.Now().Add(24 * time.Hour).UTC()
+
+	return model.Event{
+		ID:           util.RandomInt(1, 1000),
+		HostID:       hostID,
+		Name:         util.RandomName(),
+		Description:  util.RandomString(20),
+		Location:     util.RandomString(10),
+		TotalTickets: totalTickets,
+		LeftTickets:  totalTickets,
+		StartDate:    startDate,
+		EndDate:      startDate.Add(2 * time.Hour),
+		CreatedAt:    time.Now().UTC(),
+	}
+}
+
 func TestCreateEvent(t *testing.T) {
 	host, _ := randomUser(t)
 	host.Role = model.UserRole_Host
@@ -40,7 +62,7 @@ func TestCreateEvent(t *testing.T) {
 				"end_date":      "2021-01-02T00:00:00Z",
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, host.Email, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, host.Email, host.ID, host.Role, time.Minute)
 			},
 			buildStubs: func(provider *mockdb.MockProvider) {
 				arg := model.CreateEventParams{
@@ -53,13 +75,32 @@ func TestCreateEvent(t *testing.T) {
 					EndDate:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
 				}
 
-				provider.EXPECT().GetUserByEmail(gomock.Any(), host.Email).Times(1).Return(&host, nil)
 				provider.EXPECT().CreateEvent(gomock.Any(), gomock.Eq(arg)).Times(1).Return(&model.Event{}, nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusCreated, recorder.Code)
 			},
 		},
+		{
+			name: "Invalid Dates",
+			body: gin.H{
+				"name":          "Test Event",
+				"description":   "Test Description",
+				"location":      "Test Location",
+				"total_tickets": 100,
+				"start_date":    "2021-01-02T00:00:00Z",
+				"end_date":      "2021-01-01T00:00:00Z",
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, host.Email, host.ID, host.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().CreateEvent(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrInvalidEventDates)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -114,7 +155,7 @@ func TestListHostEvents(t *testing.T) {
 				Offset: 0,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, host.Email, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, host.Email, host.ID, host.Role, time.Minute)
 			},
 			buildStubs: func(provider *mockdb.MockProvider) {
 				arg := model.ListEventsParams{
@@ -122,7 +163,6 @@ func TestListHostEvents(t *testing.T) {
 					Offset: 0,
 				}
 
-				provider.EXPECT().GetUserByEmail(gomock.Any(), host.Email).Times(1).Return(&host, nil)
 				provider.EXPECT().ListEvents(gomock.Any(), gomock.Eq(arg)).Times(1).Return(&model.ListEventsResponse{}, nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
@@ -136,13 +176,13 @@ func TestListHostEvents(t *testing.T) {
 				Offset: 0,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, user.ID, user.Role, time.Minute)
 			},
 			buildStubs: func(provider *mockdb.MockProvider) {
 				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(&user, nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				require.Equal(t, http.StatusForbidden, recorder.Code)
 			},
 		},
 	}
@@ -179,8 +219,9 @@ func TestListHostEvents(t *testing.T) {
 
 func TestListEvents(t *testing.T) {
 	type Query struct {
-		Limit  int
-		Offset int
+		Limit   int
+		Offset  int
+		Filters map[string]string
 	}
 
 	testCases := []struct {
@@ -197,8 +238,39 @@ func TestListEvents(t *testing.T) {
 			},
 			buildStubs: func(provider *mockdb.MockProvider) {
 				arg := model.ListEventsParams{
-					Limit:  10,
-					Offset: 0,
+					Published: true,
+					Limit:     10,
+					Offset:    0,
+				}
+				provider.EXPECT().ListEvents(gomock.Any(), gomock.Eq(arg)).Times(1).Return(&model.ListEventsResponse{}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Filters",
+			query: Query{
+				Limit: 10,
+				Filters: map[string]string{
+					"period":           "upcoming",
+					"duration_days":    "2",
+					"month":            "2026-11",
+					"city":             "Berlin",
+					"min_tickets_left": "5",
+				},
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				arg := model.ListEventsParams{
+					Published: true,
+					EventFilters: model.EventFilters{
+						Period:         model.EventPeriod_Upcoming,
+						DurationDays:   2,
+						Month:          time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC),
+						City:           "Berlin",
+						MinTicketsLeft: 5,
+					},
+					Limit: 10,
 				}
 				provider.EXPECT().ListEvents(gomock.Any(), gomock.Eq(arg)).Times(1).Return(&model.ListEventsResponse{}, nil)
 			},
@@ -206,6 +278,45 @@ func TestListEvents(t *testing.T) {
 				require.Equal(t, http.StatusOK, recorder.Code)
 			},
 		},
+		{
+			name: "Invalid Period",
+			query: Query{
+				Limit:   10,
+				Filters: map[string]string{"period": "soon"},
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().ListEvents(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Month",
+			query: Query{
+				Limit:   10,
+				Filters: map[string]string{"month": "November"},
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().ListEvents(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Negative Tickets Left",
+			query: Query{
+				Limit:   10,
+				Filters: map[string]string{"min_tickets_left": "-1"},
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().ListEvents(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -229,6 +340,9 @@ func TestListEvents(t *testing.T) {
 			q := request.URL.Query()
 			q.Add("limit", fmt.Sprintf("%d", tc.query.Limit))
 			q.Add("offset", fmt.Sprintf("%d", tc.query.Offset))
+			for key, value := range tc.query.Filters {
+				q.Add(key, value)
+			}
 			request.URL.RawQuery = q.Encode()
 
 			server.router.ServeHTTP(recorder, request)
@@ -249,7 +363,8 @@ func TestGetEvent(t *testing.T) {
 			eventID: 1,
 			buildStubs: func(provider *mockdb.MockProvider) {
 				arg := model.GetEventParams{
-					EventID: 1,
+					EventID:   1,
+					Published: true,
 				}
 				provider.EXPECT().GetEvent(gomock.Any(), gomock.Eq(arg)).Times(1).Return(&model.Event{}, nil)
 			},
@@ -282,3 +397,660 @@ func TestGetEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestListEventTickets(t *testing.T) {
+	host, _ := randomUser(t)
+	host.ID = util.RandomInt(1, 1000)
+	host.Role = model.UserRole_Host
+
+	otherHost, _ := randomUser(t)
+	otherHost.ID = host.ID + 1
+	otherHost.Role = model.UserRole_Host
+
+	event := randomEvent(t, host.ID)
+	buyer, _ := randomUser(t)
+	tickets := []model.EventTicket{
+		{
+			Ticket:     model.Ticket{ID: 1, UserID: buyer.ID, EventID: event.ID, Quantity: 2},
+			BuyerName:  buyer.Name,
+			BuyerEmail: buyer.Email,
+		},
+	}
+	stats := &model.EventSalesStats{
+		Sold:      2,
+		Remaining: event.LeftTickets,
+		Cancelled: 1,
+		Daily:     []model.DailySales{{Day: time.Now().UTC().Truncate(24 * time.Hour), Tickets: 2}},
+	}
+
+	testCases := []struct {
+		name          string
+		eventID       int64
+		query         string
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(provider *mockdb.MockProvider)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:    "OK",
+			eventID: event.ID,
+			query:   "limit=10&offset=0",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, host.Email, host.ID, host.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetEvent(gomock.Any(), gomock.Eq(model.GetEventParams{EventID: event.ID})).Times(1).Return(&event, nil)
+
+				arg := model.ListEventTicketsParams{
+					EventID: event.ID,
+					Limit:   10,
+					Offset:  0,
+				}
+				provider.EXPECT().ListEventTickets(gomock.Any(), gomock.Eq(arg)).Times(1).
+					Return(&model.ListEventTicketsResponse{Records: tickets, NextOffset: 1}, nil)
+				provider.EXPECT().GetEventSalesStats(gomock.Any(), gomock.Eq(event.ID)).Times(1).Return(stats, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res ListEventTicketsResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				require.Equal(t, 1, res.NextOffset)
+				require.Len(t, res.Records, 1)
+				require.Equal(t, buyer.Email, res.Records[0].BuyerEmail)
+				require.Equal(t, int64(2), res.Stats.Sold)
+				require.Equal(t, event.LeftTickets, res.Stats.Remaining)
+				require.Equal(t, int64(1), res.Stats.Cancelled)
+				require.Len(t, res.Stats.Daily, 1)
+			},
+		},
+		{
+			name:    "Event Of Another Host",
+			eventID: event.ID,
+			query:   "limit=10",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, otherHost.Email, otherHost.ID, otherHost.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetEvent(gomock.Any(), gomock.Any()).Times(1).Return(&event, nil)
+				provider.EXPECT().ListEventTickets(gomock.Any(), gomock.Any()).Times(0)
+				provider.EXPECT().GetEventSalesStats(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:    "Event Not Found",
+			eventID: event.ID,
+			query:   "limit=10",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, host.Email, host.ID, host.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetEvent(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrNoRows)
+				provider.EXPECT().ListEventTickets(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:    "Missing Limit",
+			eventID: event.ID,
+			query:   "offset=0",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, host.Email, host.ID, host.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetEvent(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:    "Internal Error",
+			eventID: event.ID,
+			query:   "limit=10",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, host.Email, host.ID, host.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetEvent(gomock.Any(), gomock.Any()).Times(1).Return(&event, nil)
+				provider.EXPECT().ListEventTickets(gomock.Any(), gomock.Any()).Times(1).
+					Return(&model.ListEventTicketsResponse{Records: tickets, NextOffset: 1}, nil)
+				provider.EXPECT().GetEventSalesStats(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name:    "Not A Host",
+			eventID: event.ID,
+			query:   "limit=10",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, buyer.Email, buyer.ID, buyer.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), buyer.Email).Times(1).Return(&buyer, nil)
+				provider.EXPECT().GetEvent(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			provider := mockdb.NewMockProvider(ctrl)
+			tc.buildStubs(provider)
+
+			server := newTestServer(t, provider, nil)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/hosts/events/%d/tickets?%s", tc.eventID, tc.query)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request, server.tokenMaker)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestUpdateEvent(t *testing.T) {
+	host, _ := randomUser(t)
+	host.ID = util.RandomInt(1, 1000)
+	host.Role = model.UserRole_Host
+
+	event := randomEvent(t, host.ID)
+	description := util.RandomString(20)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(provider *mockdb.MockProvider)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"description": description,
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				arg := model.UpdateEventParams{
+					EventID:     event.ID,
+					HostID:      host.ID,
+					Description: &description,
+				}
+				updated := event
+				updated.Description = description
+
+				provider.EXPECT().UpdateEventTx(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(_ context.Context, txArg model.UpdateEventTxParams) (*model.Event, error) {
+						require.Equal(t, arg, txArg.UpdateEventParams)
+
+						// Every ticket holder is told about the change
+						messages, err := txArg.AfterUpdate(&updated, []int64{1, 2})
+						require.NoError(t, err)
+						require.Len(t, messages, 2)
+						require.Equal(t, worker.TaskSendEventChanged, messages[0].TaskType)
+						require.JSONEq(t, fmt.Sprintf(`{"user_id":2,"event_id":%d}`, event.ID), string(messages[1].Payload))
+
+						return &updated, nil
+					})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var updated model.Event
+				err := json.Unmarshal(recorder.Body.Bytes(), &updated)
+				require.NoError(t, err)
+				require.Equal(t, description, updated.Description)
+			},
+		},
+		{
+			name: "Tickets Sold",
+			body: gin.H{
+				"name":          "New Name",
+				"total_tickets": 10,
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().UpdateEventTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrEventTicketsSold)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "Event Of Another Host",
+			body: gin.H{
+				"description": description,
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().UpdateEventTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrEventNotOwned)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "Event Not Found",
+			body: gin.H{
+				"description": description,
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().UpdateEventTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrNoRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Dates",
+			body: gin.H{
+				"end_date": event.StartDate.Add(-time.Hour),
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().UpdateEventTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrInvalidEventDates)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Total Tickets",
+			body: gin.H{
+				"total_tickets": 0,
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().UpdateEventTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			provider := mockdb.NewMockProvider(ctrl)
+			tc.buildStubs(provider)
+
+			server := newTestServer(t, provider, nil)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/hosts/events/%d", event.ID)
+			request, err := http.NewRequest(http.MethodPatch, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, host.Email, host.ID, host.Role, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestCancelEvent(t *testing.T) {
+	host, _ := randomUser(t)
+	host.ID = util.RandomInt(1, 1000)
+	host.Role = model.UserRole_Host
+
+	event := randomEvent(t, host.ID)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(provider *mockdb.MockProvider)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(provider *mockdb.MockProvider) {
+				arg := model.CancelEventParams{
+					EventID: event.ID,
+					HostID:  host.ID,
+				}
+				cancelled := event
+				cancelled.Status = model.EventStatus_Cancelled
+
+				provider.EXPECT().CancelEventTx(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(_ context.Context, txArg model.CancelEventTxParams) (*model.Event, error) {
+						require.Equal(t, arg, txArg.CancelEventParams)
+
+						// Every ticket holder is told once
+						messages, err := txArg.AfterCancel(&cancelled, []int64{1, 2})
+						require.NoError(t, err)
+						require.Len(t, messages, 2)
+						require.Equal(t, worker.TaskSendEventCancelled, messages[0].TaskType)
+						require.NotEqual(t, messages[0].TaskID, messages[1].TaskID)
+						require.JSONEq(t, fmt.Sprintf(`{"user_id":1,"event_id":%d}`, event.ID), string(messages[0].Payload))
+
+						return &cancelled, nil
+					})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var cancelled model.Event
+				err := json.Unmarshal(recorder.Body.Bytes(), &cancelled)
+				require.NoError(t, err)
+				require.Equal(t, model.EventStatus_Cancelled, cancelled.Status)
+			},
+		},
+		{
+			name: "Already Cancelled",
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().CancelEventTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrEventCancelled)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "Event Ended",
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().CancelEventTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrEventEnded)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "Event Of Another Host",
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().CancelEventTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrEventNotOwned)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "Event Not Found",
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().CancelEventTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrNoRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			provider := mockdb.NewMockProvider(ctrl)
+			tc.buildStubs(provider)
+
+			server := newTestServer(t, provider, nil)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/hosts/events/%d/cancel", event.ID)
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, host.Email, host.ID, host.Role, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestPublishEvent(t *testing.T) {
+	host, _ := randomUser(t)
+	host.ID = util.RandomInt(1, 1000)
+	host.Role = model.UserRole_Host
+
+	event := randomEvent(t, host.ID)
+
+	testCases := []struct {
+		name          string
+		path          string
+		buildStubs    func(provider *mockdb.MockProvider)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Publish",
+			path: "publish",
+			buildStubs: func(provider *mockdb.MockProvider) {
+				arg := model.UpdateEventStatusParams{
+					EventID: event.ID,
+					HostID:  host.ID,
+					Status:  model.EventStatus_Published,
+				}
+				published := event
+				published.Status = model.EventStatus_Published
+				provider.EXPECT().UpdateEventStatus(gomock.Any(), gomock.Eq(arg)).Times(1).Return(&published, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var published model.Event
+				err := json.Unmarshal(recorder.Body.Bytes(), &published)
+				require.NoError(t, err)
+				require.Equal(t, model.EventStatus_Published, published.Status)
+			},
+		},
+		{
+			name: "Unpublish",
+			path: "unpublish",
+			buildStubs: func(provider *mockdb.MockProvider) {
+				arg := model.UpdateEventStatusParams{
+					EventID: event.ID,
+					HostID:  host.ID,
+					Status:  model.EventStatus_Draft,
+				}
+				provider.EXPECT().UpdateEventStatus(gomock.Any(), gomock.Eq(arg)).Times(1).Return(&event, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Transition",
+			path: "publish",
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().UpdateEventStatus(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrInvalidStatusTransition)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "Tickets Sold",
+			path: "unpublish",
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().UpdateEventStatus(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrEventTicketsSold)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "Event Of Another Host",
+			path: "publish",
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().UpdateEventStatus(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrEventNotOwned)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "Event Not Found",
+			path: "publish",
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().UpdateEventStatus(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrNoRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			provider := mockdb.NewMockProvider(ctrl)
+			tc.buildStubs(provider)
+
+			server := newTestServer(t, provider, nil)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/hosts/events/%d/%s", event.ID, tc.path)
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, host.Email, host.ID, host.Role, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestSearchEvents(t *testing.T) {
+	host, _ := randomUser(t)
+	host.ID = util.RandomInt(1, 1000)
+	host.Role = model.UserRole_Host
+
+	event := randomEvent(t, host.ID)
+
+	testCases := []struct {
+		name          string
+		url           string
+		query         map[string]string
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(provider *mockdb.MockProvider)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:      "OK",
+			url:       "/events/search",
+			query:     map[string]string{"q": "rock nig", "limit": "10", "city": "Berlin", "period": "upcoming"},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				arg := model.SearchEventsParams{
+					Query:     "rock nig",
+					Published: true,
+					EventFilters: model.EventFilters{
+						Period: model.EventPeriod_Upcoming,
+						City:   "Berlin",
+					},
+					Limit: 10,
+				}
+				provider.EXPECT().SearchEvents(gomock.Any(), gomock.Eq(arg)).Times(1).Return(&model.SearchEventsResponse{
+					Records: []model.EventSearchResult{{
+						Event:    event,
+						Rank:     0.5,
+						Headline: "<b>Rock</b> <b>Night</b>",
+					}},
+					NextOffset: 1,
+				}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response model.SearchEventsResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &response)
+				require.NoError(t, err)
+				require.Len(t, response.Records, 1)
+				require.Equal(t, event.ID, response.Records[0].ID)
+				require.Equal(t, "<b>Rock</b> <b>Night</b>", response.Records[0].Headline)
+			},
+		},
+		{
+			name:      "Missing Query",
+			url:       "/events/search",
+			query:     map[string]string{"limit": "10"},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().SearchEvents(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:      "Invalid Filter",
+			url:       "/events/search",
+			query:     map[string]string{"q": "rock", "limit": "10", "month": "2026-13"},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().SearchEvents(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "Host",
+			url:   "/hosts/events/search",
+			query: map[string]string{"q": "rock", "limit": "10", "min_tickets_left": "2"},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, host.Email, host.ID, host.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				arg := model.SearchEventsParams{
+					Query:        "rock",
+					HostID:       host.ID,
+					EventFilters: model.EventFilters{MinTicketsLeft: 2},
+					Limit:        10,
+				}
+				provider.EXPECT().SearchEvents(gomock.Any(), gomock.Eq(arg)).Times(1).Return(&model.SearchEventsResponse{}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:      "Host No Authorization",
+			url:       "/hosts/events/search",
+			query:     map[string]string{"q": "rock", "limit": "10"},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().SearchEvents(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			provider := mockdb.NewMockProvider(ctrl)
+			tc.buildStubs(provider)
+
+			server := newTestServer(t, provider, nil)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, tc.url, nil)
+			require.NoError(t, err)
+
+			q := request.URL.Query()
+			for key, value := range tc.query {
+				q.Add(key, value)
+			}
+			request.URL.RawQuery = q.Encode()
+
+			tc.setupAuth(t, request, server.tokenMaker)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
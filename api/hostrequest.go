@@ -1,13 +1,15 @@
 package api
 
 import (
-	"database/sql"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/hibiken/asynq"
 	"github.com/lib/pq"
 	"github.com/yashagw/event-management-api/db/model"
 	"github.com/yashagw/event-management-api/token"
+	"github.com/yashagw/event-management-api/worker"
 )
 
 // BecomeHost godoc
@@ -17,32 +19,14 @@ import (
 // @Produce      json
 // @Success      200 {object} ResponseMessage "request to become host created"
 // @Failure      401 {object} ResponseMessage "Not Authorized"
+// @Failure      403 {object} ResponseMessage "Forbidden"
 // @Router       /users/host [post]
 // @Security     Bearer
 func (server *Server) BecomeHost(context *gin.Context) {
-	// TODO: Delete old request for user if not approved after 30 days
-
-	// Authorization to make sure payload have user role
 	payload := context.MustGet(authorizationPayloadKey).(*token.Payload)
-	userEmail := payload.Username
-
-	user, err := server.provider.GetUserByEmail(context, userEmail)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			context.JSON(http.StatusUnauthorized, ResponseMessage{Message: "Not Authorized"})
-			return
-		}
-		context.JSON(http.StatusInternalServerError, errorResponse(err))
-		return
-	}
-
-	if user.Role != model.UserRole_User {
-		context.JSON(http.StatusUnauthorized, ResponseMessage{Message: "Not Authorized"})
-		return
-	}
 
 	// Create request to become host
-	_, err = server.provider.CreateRequestToBecomeHost(context, user.ID)
+	_, err := server.provider.CreateRequestToBecomeHost(context, payload.UserID)
 	if err != nil {
 		if pqErr, ok := err.(*pq.Error); ok {
 			switch pqErr.Code.Name() {
@@ -72,29 +56,10 @@ type ListPendingUserHostRequestsParams struct {
 // @Param        offset query int false "Offset"
 // @Success      200 {object} model.ListPendingRequestsResponse
 // @Failure      401 {object} ResponseMessage "Not Authorized"
+// @Failure      403 {object} ResponseMessage "Forbidden"
 // @Router       /moderators/requests [get]
 // @Security     Bearer
 func (server *Server) ListPendingUserHostRequests(context *gin.Context) {
-	// Authorization to make sure payload have user role
-	payload := context.MustGet(authorizationPayloadKey).(*token.Payload)
-	userEmail := payload.Username
-
-	user, err := server.provider.GetUserByEmail(context, userEmail)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			context.JSON(http.StatusUnauthorized, ResponseMessage{Message: "Not Authorized"})
-			return
-		}
-		context.JSON(http.StatusInternalServerError, errorResponse(err))
-		return
-	}
-
-	// Only admin and moderator can list pending requests
-	if user.Role == model.UserRole_User || user.Role == model.UserRole_Host {
-		context.JSON(http.StatusUnauthorized, ResponseMessage{Message: "Not Authorized"})
-		return
-	}
-
 	// Listing pending requests
 	var req ListPendingUserHostRequestsParams
 	if err := context.ShouldBindQuery(&req); err != nil {
@@ -127,28 +92,11 @@ type ApproveDisapproveUserHostRequestParams struct {
 // @Param        request body ApproveDisapproveUserHostRequestParams true "Request"
 // @Success      200 {object} ResponseMessage "request approved/disapproved"
 // @Failure      401 {object} ResponseMessage "Not Authorized"
+// @Failure      403 {object} ResponseMessage "Forbidden"
 // @Router       /moderators/requests [post]
 // @Security     Bearer
 func (server *Server) ApproveDisapproveUserHostRequest(context *gin.Context) {
-	// Authorization to make sure payload have user role
 	payload := context.MustGet(authorizationPayloadKey).(*token.Payload)
-	userEmail := payload.Username
-
-	user, err := server.provider.GetUserByEmail(context, userEmail)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			context.JSON(http.StatusUnauthorized, ResponseMessage{Message: "Not Authorized"})
-			return
-		}
-		context.JSON(http.StatusInternalServerError, errorResponse(err))
-		return
-	}
-
-	// Only admin and moderator can approve requests
-	if user.Role == model.UserRole_User || user.Role == model.UserRole_Host {
-		context.JSON(http.StatusUnauthorized, ResponseMessage{Message: "Not Authorized"})
-		return
-	}
 
 	var req ApproveDisapproveUserHostRequestParams
 	if err := context.ShouldBindJSON(&req); err != nil {
@@ -156,12 +104,31 @@ func (server *Server) ApproveDisapproveUserHostRequest(context *gin.Context) {
 		return
 	}
 
-	dbReq := model.ApproveDisapproveRequestToBecomeHostParams{
-		RequestID:   req.RequestID,
-		Approved:    req.Approved,
-		ModeratorID: user.ID,
+	dbReq := model.ApproveDisapproveRequestToBecomeHostTxParams{
+		ApproveDisapproveRequestToBecomeHostParams: model.ApproveDisapproveRequestToBecomeHostParams{
+			RequestID:   req.RequestID,
+			Approved:    req.Approved,
+			ModeratorID: payload.UserID,
+		},
+		AfterUpdate: func(request *model.UserHostRequest) ([]model.CreateOutboxMessageParams, error) {
+			taskPayload := &worker.PayloadSendHostRequestDecision{
+				UserID:    request.UserID,
+				RequestID: request.ID,
+				Approved:  request.Status == model.UserHostRequestStatus_Approved,
+			}
+			opts := []asynq.Option{
+				asynq.MaxRetry(10),
+				asynq.Timeout(10 * time.Second),
+				asynq.Queue(worker.QueueDefault),
+			}
+			message, err := worker.NewOutboxMessage(worker.TaskSendHostRequestDecision, taskPayload, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return []model.CreateOutboxMessageParams{message}, nil
+		},
 	}
-	err = server.provider.ApproveDisapproveRequestToBecomeHost(context, dbReq)
+	_, err := server.provider.ApproveDisapproveRequestToBecomeHostTx(context, dbReq)
 	if err != nil {
 		context.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
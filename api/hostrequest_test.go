@@ -2,6 +2,7 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
@@ -17,6 +18,7 @@ import (
 	mockdb "github.com/yashagw/event-management-api/db/mock"
 	"github.com/yashagw/event-management-api/db/model"
 	"github.com/yashagw/event-management-api/token"
+	"github.com/yashagw/event-management-api/worker"
 	mockwk "github.com/yashagw/event-management-api/worker/mock"
 )
 
@@ -39,7 +41,7 @@ func TestApproveDisapproveUserHostRequest(t *testing.T) {
 		name          string
 		body          gin.H
 		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
-		buildStubs    func(provider *mockdb.MockProvider)
+		buildStubs    func(provider *mockdb.MockProvider, distributor *mockwk.MockTaskDistributor)
 		checkResponse func(recorder *httptest.ResponseRecorder)
 	}{
 		{
@@ -49,17 +51,33 @@ func TestApproveDisapproveUserHostRequest(t *testing.T) {
 				"request_id": 1,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, moderator.Email, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, moderator.Email, moderator.ID, moderator.Role, time.Minute)
 			},
-			buildStubs: func(provider *mockdb.MockProvider) {
+			buildStubs: func(provider *mockdb.MockProvider, distributor *mockwk.MockTaskDistributor) {
 				arg := model.ApproveDisapproveRequestToBecomeHostParams{
 					RequestID:   1,
 					Approved:    true,
 					ModeratorID: moderator.ID,
 				}
+				request := randomUserHostRequest(t, &user, model.UserHostRequestStatus_Approved)
+				request.ID = 1
+
+				provider.EXPECT().ApproveDisapproveRequestToBecomeHostTx(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(_ context.Context, txArg model.ApproveDisapproveRequestToBecomeHostTxParams) (*model.UserHostRequest, error) {
+						require.Equal(t, arg, txArg.ApproveDisapproveRequestToBecomeHostParams)
+
+						// The decision email is written to the outbox with the decision
+						messages, err := txArg.AfterUpdate(&request)
+						require.NoError(t, err)
+						require.Len(t, messages, 1)
+						require.Equal(t, worker.TaskSendHostRequestDecision, messages[0].TaskType)
 
-				provider.EXPECT().GetUserByEmail(gomock.Any(), moderator.Email).Times(1).Return(&moderator, nil)
-				provider.EXPECT().ApproveDisapproveRequestToBecomeHost(gomock.Any(), gomock.Eq(arg)).Times(1).Return(nil)
+						var payload worker.PayloadSendHostRequestDecision
+						require.NoError(t, json.Unmarshal(messages[0].Payload, &payload))
+						require.Equal(t, worker.PayloadSendHostRequestDecision{UserID: user.ID, RequestID: 1, Approved: true}, payload)
+
+						return &request, nil
+					})
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
@@ -72,17 +90,33 @@ func TestApproveDisapproveUserHostRequest(t *testing.T) {
 				"request_id": 1,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, moderator.Email, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, moderator.Email, moderator.ID, moderator.Role, time.Minute)
 			},
-			buildStubs: func(provider *mockdb.MockProvider) {
+			buildStubs: func(provider *mockdb.MockProvider, distributor *mockwk.MockTaskDistributor) {
 				arg := model.ApproveDisapproveRequestToBecomeHostParams{
 					RequestID:   1,
 					Approved:    false,
 					ModeratorID: moderator.ID,
 				}
+				request := randomUserHostRequest(t, &user, model.UserHostRequestStatus_Rejected)
+				request.ID = 1
+
+				provider.EXPECT().ApproveDisapproveRequestToBecomeHostTx(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(_ context.Context, txArg model.ApproveDisapproveRequestToBecomeHostTxParams) (*model.UserHostRequest, error) {
+						require.Equal(t, arg, txArg.ApproveDisapproveRequestToBecomeHostParams)
+
+						// The decision email is written to the outbox with the decision
+						messages, err := txArg.AfterUpdate(&request)
+						require.NoError(t, err)
+						require.Len(t, messages, 1)
+						require.Equal(t, worker.TaskSendHostRequestDecision, messages[0].TaskType)
 
-				provider.EXPECT().GetUserByEmail(gomock.Any(), moderator.Email).Times(1).Return(&moderator, nil)
-				provider.EXPECT().ApproveDisapproveRequestToBecomeHost(gomock.Any(), gomock.Eq(arg)).Times(1).Return(nil)
+						var payload worker.PayloadSendHostRequestDecision
+						require.NoError(t, json.Unmarshal(messages[0].Payload, &payload))
+						require.Equal(t, worker.PayloadSendHostRequestDecision{UserID: user.ID, RequestID: 1, Approved: false}, payload)
+
+						return &request, nil
+					})
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
@@ -97,7 +131,7 @@ func TestApproveDisapproveUserHostRequest(t *testing.T) {
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
 				// Don't add authorization header
 			},
-			buildStubs: func(provider *mockdb.MockProvider) {
+			buildStubs: func(provider *mockdb.MockProvider, distributor *mockwk.MockTaskDistributor) {
 				// Don't expect any calls to the database
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
@@ -111,13 +145,13 @@ func TestApproveDisapproveUserHostRequest(t *testing.T) {
 				"request_id": 1,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, user.ID, user.Role, time.Minute)
 			},
-			buildStubs: func(provider *mockdb.MockProvider) {
+			buildStubs: func(provider *mockdb.MockProvider, distributor *mockwk.MockTaskDistributor) {
 				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(&user, nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				require.Equal(t, http.StatusForbidden, recorder.Code)
 			},
 		},
 	}
@@ -127,11 +161,11 @@ func TestApproveDisapproveUserHostRequest(t *testing.T) {
 			providerCtrl := gomock.NewController(t)
 			defer providerCtrl.Finish()
 			provider := mockdb.NewMockProvider(providerCtrl)
-			tc.buildStubs(provider)
 
 			redisCtrl := gomock.NewController(t)
 			defer redisCtrl.Finish()
 			distributor := mockwk.NewMockTaskDistributor(redisCtrl)
+			tc.buildStubs(provider, distributor)
 
 			server := newTestServer(t, provider, distributor)
 			recorder := httptest.NewRecorder()
@@ -174,7 +208,7 @@ func TestListPendingUserHostRequests(t *testing.T) {
 				Offset: 0,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, moderator.Email, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, moderator.Email, moderator.ID, moderator.Role, time.Minute)
 			},
 			buildStubs: func(provider *mockdb.MockProvider) {
 				var requests []*model.UserHostRequest
@@ -193,8 +227,6 @@ func TestListPendingUserHostRequests(t *testing.T) {
 					NextOffset: 5,
 				}
 
-				provider.EXPECT().GetUserByEmail(gomock.Any(), gomock.Eq(moderator.Email)).
-					Times(1).Return(&moderator, nil)
 				provider.EXPECT().ListPendingRequests(gomock.Any(), gomock.Eq(arg)).
 					Times(1).Return(&res, nil)
 			},
@@ -209,14 +241,14 @@ func TestListPendingUserHostRequests(t *testing.T) {
 				Offset: 0,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, user.ID, user.Role, time.Minute)
 			},
 			buildStubs: func(provider *mockdb.MockProvider) {
 				provider.EXPECT().GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
 					Times(1).Return(&user, nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				require.Equal(t, http.StatusForbidden, recorder.Code)
 			},
 		},
 		{
@@ -226,7 +258,7 @@ func TestListPendingUserHostRequests(t *testing.T) {
 				Offset: 0,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, moderator.Email, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, moderator.Email, moderator.ID, moderator.Role, time.Minute)
 			},
 			buildStubs: func(provider *mockdb.MockProvider) {
 				arg := model.ListPendingRequestsParams{
@@ -234,8 +266,6 @@ func TestListPendingUserHostRequests(t *testing.T) {
 					Offset: 0,
 				}
 
-				provider.EXPECT().GetUserByEmail(gomock.Any(), gomock.Eq(moderator.Email)).
-					Times(1).Return(&moderator, nil)
 				provider.EXPECT().ListPendingRequests(gomock.Any(), gomock.Eq(arg)).
 					Times(1).Return(nil, sql.ErrConnDone)
 			},
@@ -281,6 +311,9 @@ func TestListPendingUserHostRequests(t *testing.T) {
 
 func TestBecomeHostRequest(t *testing.T) {
 	user, _ := randomUser(t)
+	user.IsEmailVerified = true
+
+	unverifiedUser, _ := randomUser(t)
 
 	host, _ := randomUser(t)
 	host.Role = model.UserRole_Host
@@ -294,11 +327,10 @@ func TestBecomeHostRequest(t *testing.T) {
 		{
 			name: "Okay",
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, user.ID, user.Role, time.Minute)
 			},
 			buildStubs: func(provider *mockdb.MockProvider) {
-				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).
-					Times(1).Return(&user, nil)
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(&user, nil)
 				provider.EXPECT().CreateRequestToBecomeHost(gomock.Any(), user.ID).
 					Times(1).Return(nil, nil)
 			},
@@ -310,7 +342,8 @@ func TestBecomeHostRequest(t *testing.T) {
 		{
 			name: "Not Authorized",
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "wrongemail", time.Minute)
+				// Role not allowed by the token, so the user is looked up and not found
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "wrongemail", 0, model.UserRole_Moderator, time.Minute)
 			},
 			buildStubs: func(provider *mockdb.MockProvider) {
 				provider.EXPECT().GetUserByEmail(gomock.Any(), "wrongemail").
@@ -323,24 +356,36 @@ func TestBecomeHostRequest(t *testing.T) {
 		{
 			name: "Already Host",
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, host.Email, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, host.Email, host.ID, host.Role, time.Minute)
 			},
 			buildStubs: func(provider *mockdb.MockProvider) {
 				provider.EXPECT().GetUserByEmail(gomock.Any(), host.Email).
 					Times(1).Return(&host, nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "Email Not Verified",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, unverifiedUser.Email, unverifiedUser.ID, unverifiedUser.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), unverifiedUser.Email).Times(1).Return(&unverifiedUser, nil)
+				provider.EXPECT().CreateRequestToBecomeHost(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
 			},
 		},
 		{
 			name: "Internal Error",
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, user.ID, user.Role, time.Minute)
 			},
 			buildStubs: func(provider *mockdb.MockProvider) {
-				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).
-					Times(1).Return(&user, nil)
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(&user, nil)
 				provider.EXPECT().CreateRequestToBecomeHost(gomock.Any(), user.ID).
 					Times(1).Return(nil, sql.ErrConnDone)
 			},
@@ -351,11 +396,10 @@ func TestBecomeHostRequest(t *testing.T) {
 		{
 			name: "Unique Constraint Violation",
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, user.ID, user.Role, time.Minute)
 			},
 			buildStubs: func(provider *mockdb.MockProvider) {
-				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).
-					Times(1).Return(&user, nil)
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(&user, nil)
 				provider.EXPECT().CreateRequestToBecomeHost(gomock.Any(), user.ID).
 					Times(1).Return(nil, &pq.Error{Code: "23505"})
 			},
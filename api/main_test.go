@@ -6,18 +6,44 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/yashagw/event-management-api/db"
+	"github.com/yashagw/event-management-api/revocation"
+	mockrv "github.com/yashagw/event-management-api/revocation/mock"
 	"github.com/yashagw/event-management-api/util"
 	"github.com/yashagw/event-management-api/worker"
 )
 
+// newTestServer creates a server whose revocation list reports every token as valid
 func newTestServer(t *testing.T, provider db.Provider, distributor worker.TaskDistributor) *Server {
+	ctrl := gomock.NewController(t)
+	revocationList := mockrv.NewMockList(ctrl)
+	revocationList.EXPECT().IsRevoked(gomock.Any(), gomock.Any()).AnyTimes().Return(false, nil)
+
+	return newTestServerWithRevocationList(t, provider, distributor, revocationList)
+}
+
+// newTestServerWithInspector creates a server whose admin routes use inspector
+func newTestServerWithInspector(t *testing.T, provider db.Provider, inspector worker.TaskInspector) *Server {
+	server := newTestServer(t, provider, nil)
+	server.inspector = inspector
+	return server
+}
+
+func newTestServerWithRevocationList(
+	t *testing.T,
+	provider db.Provider,
+	distributor worker.TaskDistributor,
+	revocationList revocation.List,
+) *Server {
 	config := util.Config{
-		TokenSymmetricKey:   util.RandomString(32),
-		AccessTokenDuration: time.Minute,
+		TokenSymmetricKey:    util.RandomString(32),
+		AccessTokenDuration:  time.Minute,
+		RefreshTokenDuration: time.Hour,
+		VerifyEmailInterval:  time.Minute,
 	}
-	server, err := NewServer(config, provider, distributor)
+	server, err := NewServer(config, provider, distributor, nil, revocationList)
 	require.NoError(t, err)
 
 	return server
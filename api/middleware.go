@@ -1,12 +1,15 @@
 package api
 
 import (
+	"database/sql"
 	"errors"
 	"fmt"
 	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/revocation"
 	"github.com/yashagw/event-management-api/token"
 )
 
@@ -14,9 +17,11 @@ const (
 	authorizationHeaderKey  = "authorization"
 	authorizationTypeBearer = "bearer"
 	authorizationPayloadKey = "authorization_payload"
+	// authorizationUserKey holds the user once a middleware has loaded it
+	authorizationUserKey = "authorization_user"
 )
 
-func authMiddleware(tokenMaker token.Maker) gin.HandlerFunc {
+func authMiddleware(tokenMaker token.Maker, revocationList revocation.List) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		authorizationHeader := ctx.GetHeader(authorizationHeaderKey)
 		if len(authorizationHeader) == 0 {
@@ -45,8 +50,106 @@ func authMiddleware(tokenMaker token.Maker) gin.HandlerFunc {
 			ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
 			return
 		}
+		if err := payload.CheckType(token.TokenTypeAccess); err != nil {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
+			return
+		}
+
+		revoked, err := revocationList.IsRevoked(ctx, payload.ID)
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+		if revoked {
+			err := errors.New("token has been revoked")
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
+			return
+		}
 
 		ctx.Set(authorizationPayloadKey, payload)
 		ctx.Next()
 	}
 }
+
+// requireRole must be used after authMiddleware. It authorizes from the role
+// embedded in the token and only goes to the database when that role is not
+// allowed, because the role may have changed since the token was issued
+// (e.g. a user whose host request was just approved).
+func (server *Server) requireRole(roles ...model.UserRole) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		payload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+		if hasRole(payload.Role, roles) {
+			ctx.Next()
+			return
+		}
+
+		user, err := server.authorizedUser(ctx)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				ctx.AbortWithStatusJSON(http.StatusUnauthorized, ResponseMessage{Message: "Not Authorized"})
+				return
+			}
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		if !hasRole(user.Role, roles) {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, ResponseMessage{Message: "Forbidden"})
+			return
+		}
+
+		payload.Role = user.Role
+		ctx.Next()
+	}
+}
+
+func hasRole(role model.UserRole, roles []model.UserRole) bool {
+	for _, r := range roles {
+		if role == r {
+			return true
+		}
+	}
+	return false
+}
+
+// requireVerifiedEmail must be used after authMiddleware. The verified flag is
+// not part of the token, so it is read from the database unless requireRole
+// already loaded the user.
+func (server *Server) requireVerifiedEmail() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := server.authorizedUser(ctx)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				ctx.AbortWithStatusJSON(http.StatusUnauthorized, ResponseMessage{Message: "Not Authorized"})
+				return
+			}
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		if !user.IsEmailVerified {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, ResponseMessage{Message: "email is not verified"})
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+// authorizedUser returns the user of the access token, loading it from the
+// database the first time and keeping it in the context for the next
+// middlewares and the handler.
+func (server *Server) authorizedUser(ctx *gin.Context) (*model.User, error) {
+	if user, ok := ctx.Get(authorizationUserKey); ok {
+		return user.(*model.User), nil
+	}
+
+	payload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	user, err := server.provider.GetUserByEmail(ctx, payload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.Set(authorizationUserKey, user)
+	return user, nil
+}
@@ -2,39 +2,52 @@ package api
 
 import (
 	"fmt"
+	"net/http"
 
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 	_ "github.com/yashagw/event-management-api/docs"
+	"github.com/yashagw/event-management-api/revocation"
 	"github.com/yashagw/event-management-api/worker"
 
 	"github.com/gin-gonic/gin"
 	"github.com/yashagw/event-management-api/db"
+	"github.com/yashagw/event-management-api/db/model"
 	"github.com/yashagw/event-management-api/token"
 	"github.com/yashagw/event-management-api/util"
 )
 
 // Server will serve HTTP requests for our event service.
 type Server struct {
-	provider    db.Provider
-	config      util.Config
-	tokenMaker  token.Maker
-	router      *gin.Engine
-	distributor worker.TaskDistributor
+	provider       db.Provider
+	config         util.Config
+	tokenMaker     token.Maker
+	router         *gin.Engine
+	distributor    worker.TaskDistributor
+	inspector      worker.TaskInspector
+	revocationList revocation.List
 }
 
 // NewServer creates a new HTTP server and sets up routing.
-func NewServer(config util.Config, provider db.Provider, distributor worker.TaskDistributor) (*Server, error) {
-	tokenMaker, err := token.NewPasetoMaker(config.TokenSymmetricKey)
+func NewServer(
+	config util.Config,
+	provider db.Provider,
+	distributor worker.TaskDistributor,
+	inspector worker.TaskInspector,
+	revocationList revocation.List,
+) (*Server, error) {
+	tokenMaker, err := token.NewMaker(config)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create token maker: %w", err)
 	}
 
 	server := &Server{
-		provider:    provider,
-		config:      config,
-		tokenMaker:  tokenMaker,
-		distributor: distributor,
+		provider:       provider,
+		config:         config,
+		tokenMaker:     tokenMaker,
+		distributor:    distributor,
+		inspector:      inspector,
+		revocationList: revocationList,
 	}
 
 	server.setupRouter()
@@ -47,22 +60,57 @@ func (server *Server) setupRouter() {
 	router.GET("/docs/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
 	router.GET("/events", server.ListEvents)
+	router.GET("/events/search", server.SearchEvents)
 	router.GET("/events/:event_id", server.GetEvent)
 
 	router.POST("/users", server.CreateUser)
 	router.POST("/users/login", server.LoginUser)
-
-	userAuthRoutes := router.Group("/").Use(authMiddleware(server.tokenMaker))
+	router.GET("/users/verify_email", server.VerifyEmail)
+	router.POST("/tokens/renew_access", server.RenewAccessToken)
+
+	authRoutes := router.Group("/").Use(authMiddleware(server.tokenMaker, server.revocationList))
+	authRoutes.POST("/users/logout", server.LogoutUser)
+	authRoutes.POST("/users/logout_all", server.LogoutAllSessions)
+	authRoutes.POST("/users/resend_verify_email", server.ResendVerifyEmail)
+
+	userAuthRoutes := router.Group("/").Use(
+		authMiddleware(server.tokenMaker, server.revocationList),
+		server.requireRole(model.UserRole_User),
+		server.requireVerifiedEmail(),
+	)
 	userAuthRoutes.POST("/users/host", server.BecomeHost)
 	userAuthRoutes.POST("/users/ticket", server.CreateTicket)
+	userAuthRoutes.GET("/users/tickets", server.ListTickets)
+	userAuthRoutes.DELETE("/users/tickets/:ticket_id", server.CancelTicket)
 
-	moderatorAuthRoutes := router.Group("/").Use(authMiddleware(server.tokenMaker))
+	moderatorAuthRoutes := router.Group("/").Use(
+		authMiddleware(server.tokenMaker, server.revocationList),
+		server.requireRole(model.UserRole_Moderator, model.UserRole_Admin),
+	)
 	moderatorAuthRoutes.GET("/moderator/requests", server.ListPendingUserHostRequests)
 	moderatorAuthRoutes.POST("/moderator/requests", server.ApproveDisapproveUserHostRequest)
 
-	hostAuthRoutes := router.Group("/").Use(authMiddleware(server.tokenMaker))
+	hostAuthRoutes := router.Group("/").Use(
+		authMiddleware(server.tokenMaker, server.revocationList),
+		server.requireRole(model.UserRole_Host),
+	)
 	hostAuthRoutes.POST("/hosts/events", server.CreateEvent)
 	hostAuthRoutes.GET("/hosts/events", server.ListHostEvents)
+	hostAuthRoutes.GET("/hosts/events/search", server.SearchHostEvents)
+	hostAuthRoutes.PATCH("/hosts/events/:event_id", server.UpdateEvent)
+	hostAuthRoutes.POST("/hosts/events/:event_id/publish", server.PublishEvent)
+	hostAuthRoutes.POST("/hosts/events/:event_id/unpublish", server.UnpublishEvent)
+	hostAuthRoutes.POST("/hosts/events/:event_id/cancel", server.CancelEvent)
+	hostAuthRoutes.GET("/hosts/events/:event_id/tickets", server.ListEventTickets)
+
+	adminAuthRoutes := router.Group("/").Use(
+		authMiddleware(server.tokenMaker, server.revocationList),
+		server.requireRole(model.UserRole_Admin),
+	)
+	adminAuthRoutes.GET("/admin/queues/:queue/tasks", server.ListFailedTasks)
+	adminAuthRoutes.GET("/admin/queues/:queue/tasks/:task_id", server.GetTask)
+	adminAuthRoutes.POST("/admin/queues/:queue/tasks/:task_id/retry", server.RetryTask)
+	adminAuthRoutes.DELETE("/admin/queues/:queue/tasks/:task_id", server.DeleteTask)
 
 	server.router = router
 }
@@ -71,6 +119,11 @@ func (server *Server) Start(address string) error {
 	return server.router.Run(address)
 }
 
+// Handler returns the router so that the server can be run by an http.Server.
+func (server *Server) Handler() http.Handler {
+	return server.router
+}
+
 func errorResponse(err error) gin.H {
 	return gin.H{"error": err.Error()}
 }
@@ -0,0 +1,218 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hibiken/asynq"
+	"github.com/yashagw/event-management-api/worker"
+)
+
+// TaskResponse describes a background task, payload is the json the task was enqueued with
+type TaskResponse struct {
+	ID            string          `json:"id"`
+	Queue         string          `json:"queue"`
+	Type          string          `json:"type"`
+	Payload       json.RawMessage `json:"payload" swaggertype:"object"`
+	State         string          `json:"state"`
+	MaxRetry      int             `json:"max_retry"`
+	Retried       int             `json:"retried"`
+	LastErr       string          `json:"last_error"`
+	LastFailedAt  *time.Time      `json:"last_failed_at"`
+	NextProcessAt *time.Time      `json:"next_process_at"`
+}
+
+type ListFailedTasksResponse struct {
+	Records []TaskResponse `json:"records"`
+}
+
+func newTaskResponse(info *asynq.TaskInfo) TaskResponse {
+	response := TaskResponse{
+		ID:       info.ID,
+		Queue:    info.Queue,
+		Type:     info.Type,
+		State:    info.State.String(),
+		MaxRetry: info.MaxRetry,
+		Retried:  info.Retried,
+		LastErr:  info.LastErr,
+	}
+	if len(info.Payload) > 0 && json.Valid(info.Payload) {
+		response.Payload = info.Payload
+	}
+	if !info.LastFailedAt.IsZero() {
+		response.LastFailedAt = &info.LastFailedAt
+	}
+	if !info.NextProcessAt.IsZero() {
+		response.NextProcessAt = &info.NextProcessAt
+	}
+	return response
+}
+
+// taskErrorResponse maps the errors of a worker.TaskInspector to a status
+func taskErrorResponse(context *gin.Context, err error) {
+	switch {
+	case errors.Is(err, asynq.ErrQueueNotFound), errors.Is(err, asynq.ErrTaskNotFound):
+		context.JSON(http.StatusNotFound, errorResponse(err))
+	case errors.Is(err, worker.ErrTaskNotFailed):
+		context.JSON(http.StatusConflict, errorResponse(err))
+	default:
+		context.JSON(http.StatusInternalServerError, errorResponse(err))
+	}
+}
+
+type ListFailedTasksUri struct {
+	Queue string `uri:"queue" binding:"required"`
+}
+
+type ListFailedTasksParams struct {
+	State    string `form:"state" binding:"required,oneof=retry archived"`
+	Page     int    `form:"page" binding:"omitempty,min=1"`
+	PageSize int    `form:"page_size" binding:"required,min=1,max=100"`
+}
+
+// ListFailedTasks godoc
+// @Summary      Lists failed tasks of a queue.
+// @Description  Lists the tasks of a queue that wait for a retry or ran out of retries and were archived.
+// @Tags         admin
+// @Produce      json
+// @Param        queue path string true "Queue"
+// @Param        state query string true "State" Enums(retry, archived)
+// @Param        page query int false "Page, starts at 1"
+// @Param        page_size query int true "Page size"
+// @Success      200 {object} ListFailedTasksResponse
+// @Failure      401 {object} ResponseMessage "Not Authorized"
+// @Failure      403 {object} ResponseMessage "Forbidden"
+// @Failure      404 {object} ResponseMessage "Queue not found"
+// @Router       /admin/queues/{queue}/tasks [get]
+// @Security     Bearer
+func (server *Server) ListFailedTasks(context *gin.Context) {
+	var uri ListFailedTasksUri
+	if err := context.ShouldBindUri(&uri); err != nil {
+		context.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req ListFailedTasksParams
+	if err := context.ShouldBindQuery(&req); err != nil {
+		context.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	if req.Page == 0 {
+		req.Page = 1
+	}
+
+	state := asynq.TaskStateRetry
+	if req.State == asynq.TaskStateArchived.String() {
+		state = asynq.TaskStateArchived
+	}
+
+	tasks, err := server.inspector.ListFailedTasks(uri.Queue, state, req.Page, req.PageSize)
+	if err != nil {
+		taskErrorResponse(context, err)
+		return
+	}
+
+	response := ListFailedTasksResponse{Records: []TaskResponse{}}
+	for _, task := range tasks {
+		response.Records = append(response.Records, newTaskResponse(task))
+	}
+
+	context.JSON(http.StatusOK, response)
+}
+
+type TaskUri struct {
+	Queue  string `uri:"queue" binding:"required"`
+	TaskID string `uri:"task_id" binding:"required"`
+}
+
+// GetTask godoc
+// @Summary      Gets a task.
+// @Description  Gets a task with its payload and last error.
+// @Tags         admin
+// @Produce      json
+// @Param        queue path string true "Queue"
+// @Param        task_id path string true "Task ID"
+// @Success      200 {object} TaskResponse
+// @Failure      401 {object} ResponseMessage "Not Authorized"
+// @Failure      403 {object} ResponseMessage "Forbidden"
+// @Failure      404 {object} ResponseMessage "Task not found"
+// @Router       /admin/queues/{queue}/tasks/{task_id} [get]
+// @Security     Bearer
+func (server *Server) GetTask(context *gin.Context) {
+	var uri TaskUri
+	if err := context.ShouldBindUri(&uri); err != nil {
+		context.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	task, err := server.inspector.GetTask(uri.Queue, uri.TaskID)
+	if err != nil {
+		taskErrorResponse(context, err)
+		return
+	}
+
+	context.JSON(http.StatusOK, newTaskResponse(task))
+}
+
+// RetryTask godoc
+// @Summary      Retries a failed task.
+// @Description  Runs a task that waits for a retry or was archived again right away.
+// @Tags         admin
+// @Produce      json
+// @Param        queue path string true "Queue"
+// @Param        task_id path string true "Task ID"
+// @Success      200 {object} ResponseMessage "task queued"
+// @Failure      401 {object} ResponseMessage "Not Authorized"
+// @Failure      403 {object} ResponseMessage "Forbidden"
+// @Failure      404 {object} ResponseMessage "Task not found"
+// @Failure      409 {object} ResponseMessage "Task has not failed"
+// @Router       /admin/queues/{queue}/tasks/{task_id}/retry [post]
+// @Security     Bearer
+func (server *Server) RetryTask(context *gin.Context) {
+	var uri TaskUri
+	if err := context.ShouldBindUri(&uri); err != nil {
+		context.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	err := server.inspector.RetryTask(uri.Queue, uri.TaskID)
+	if err != nil {
+		taskErrorResponse(context, err)
+		return
+	}
+
+	context.JSON(http.StatusOK, ResponseMessage{Message: "task queued"})
+}
+
+// DeleteTask godoc
+// @Summary      Deletes a failed task.
+// @Description  Deletes a task that waits for a retry or was archived.
+// @Tags         admin
+// @Produce      json
+// @Param        queue path string true "Queue"
+// @Param        task_id path string true "Task ID"
+// @Success      200 {object} ResponseMessage "task deleted"
+// @Failure      401 {object} ResponseMessage "Not Authorized"
+// @Failure      403 {object} ResponseMessage "Forbidden"
+// @Failure      404 {object} ResponseMessage "Task not found"
+// @Failure      409 {object} ResponseMessage "Task has not failed"
+// @Router       /admin/queues/{queue}/tasks/{task_id} [delete]
+// @Security     Bearer
+func (server *Server) DeleteTask(context *gin.Context) {
+	var uri TaskUri
+	if err := context.ShouldBindUri(&uri); err != nil {
+		context.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	err := server.inspector.DeleteTask(uri.Queue, uri.TaskID)
+	if err != nil {
+		taskErrorResponse(context, err)
+		return
+	}
+
+	context.JSON(http.StatusOK, ResponseMessage{Message: "task deleted"})
+}
@@ -0,0 +1,259 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yashagw/event-management-api/db/mock"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/token"
+	"github.com/yashagw/event-management-api/worker"
+	mockwk "github.com/yashagw/event-management-api/worker/mock"
+)
+
+func randomTaskInfo(state asynq.TaskState) *asynq.TaskInfo {
+	return &asynq.TaskInfo{
+		ID:           "task-id",
+		Queue:        worker.QueueCritical,
+		Type:         worker.TaskSendVerifyEmail,
+		Payload:      []byte(`{"email":"jane@example.com"}`),
+		State:        state,
+		MaxRetry:     3,
+		Retried:      3,
+		LastErr:      "smtp is down",
+		LastFailedAt: time.Now().UTC().Truncate(time.Second),
+	}
+}
+
+func TestListFailedTasks(t *testing.T) {
+	admin, _ := randomUser(t)
+	admin.Role = model.UserRole_Admin
+
+	moderator, _ := randomUser(t)
+	moderator.Role = model.UserRole_Moderator
+
+	task := randomTaskInfo(asynq.TaskStateArchived)
+
+	testCases := []struct {
+		name          string
+		queue         string
+		query         string
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(provider *mockdb.MockProvider, inspector *mockwk.MockTaskInspector)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK",
+			queue: worker.QueueCritical,
+			query: "state=archived&page_size=10",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, admin.Email, admin.ID, admin.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider, inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().ListFailedTasks(worker.QueueCritical, asynq.TaskStateArchived, 1, 10).
+					Times(1).Return([]*asynq.TaskInfo{task}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+
+				var response ListFailedTasksResponse
+				require.NoError(t, json.Unmarshal(data, &response))
+				require.Len(t, response.Records, 1)
+				require.Equal(t, task.ID, response.Records[0].ID)
+				require.Equal(t, "archived", response.Records[0].State)
+				require.Equal(t, task.LastErr, response.Records[0].LastErr)
+				require.JSONEq(t, string(task.Payload), string(response.Records[0].Payload))
+				require.True(t, task.LastFailedAt.Equal(*response.Records[0].LastFailedAt))
+				require.Nil(t, response.Records[0].NextProcessAt)
+			},
+		},
+		{
+			name:  "Retry",
+			queue: worker.QueueDefault,
+			query: "state=retry&page=2&page_size=5",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, admin.Email, admin.ID, admin.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider, inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().ListFailedTasks(worker.QueueDefault, asynq.TaskStateRetry, 2, 5).
+					Times(1).Return(nil, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.JSONEq(t, `{"records":[]}`, recorder.Body.String())
+			},
+		},
+		{
+			name:  "InvalidState",
+			queue: worker.QueueCritical,
+			query: "state=pending&page_size=10",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, admin.Email, admin.ID, admin.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider, inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().ListFailedTasks(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "QueueNotFound",
+			queue: "unknown",
+			query: "state=archived&page_size=10",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, admin.Email, admin.ID, admin.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider, inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().ListFailedTasks("unknown", asynq.TaskStateArchived, 1, 10).
+					Times(1).Return(nil, fmt.Errorf("asynq: %w", asynq.ErrQueueNotFound))
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:  "Forbidden",
+			queue: worker.QueueCritical,
+			query: "state=archived&page_size=10",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, moderator.Email, moderator.ID, moderator.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider, inspector *mockwk.MockTaskInspector) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), moderator.Email).Times(1).Return(&moderator, nil)
+				inspector.EXPECT().ListFailedTasks(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			provider := mockdb.NewMockProvider(ctrl)
+			inspector := mockwk.NewMockTaskInspector(ctrl)
+			tc.buildStubs(provider, inspector)
+
+			server := newTestServerWithInspector(t, provider, inspector)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/admin/queues/%s/tasks?%s", tc.queue, tc.query)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request, server.tokenMaker)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestGetRetryDeleteTask(t *testing.T) {
+	admin, _ := randomUser(t)
+	admin.Role = model.UserRole_Admin
+
+	task := randomTaskInfo(asynq.TaskStateArchived)
+	path := fmt.Sprintf("/admin/queues/%s/tasks/%s", task.Queue, task.ID)
+
+	testCases := []struct {
+		name       string
+		method     string
+		path       string
+		buildStubs func(inspector *mockwk.MockTaskInspector)
+		status     int
+	}{
+		{
+			name:   "Get",
+			method: http.MethodGet,
+			path:   path,
+			buildStubs: func(inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().GetTask(task.Queue, task.ID).Times(1).Return(task, nil)
+			},
+			status: http.StatusOK,
+		},
+		{
+			name:   "GetNotFound",
+			method: http.MethodGet,
+			path:   path,
+			buildStubs: func(inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().GetTask(task.Queue, task.ID).Times(1).Return(nil, asynq.ErrTaskNotFound)
+			},
+			status: http.StatusNotFound,
+		},
+		{
+			name:   "Retry",
+			method: http.MethodPost,
+			path:   path + "/retry",
+			buildStubs: func(inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().RetryTask(task.Queue, task.ID).Times(1).Return(nil)
+			},
+			status: http.StatusOK,
+		},
+		{
+			name:   "RetryNotFailed",
+			method: http.MethodPost,
+			path:   path + "/retry",
+			buildStubs: func(inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().RetryTask(task.Queue, task.ID).Times(1).Return(worker.ErrTaskNotFailed)
+			},
+			status: http.StatusConflict,
+		},
+		{
+			name:   "Delete",
+			method: http.MethodDelete,
+			path:   path,
+			buildStubs: func(inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().DeleteTask(task.Queue, task.ID).Times(1).Return(nil)
+			},
+			status: http.StatusOK,
+		},
+		{
+			name:   "DeleteInternalError",
+			method: http.MethodDelete,
+			path:   path,
+			buildStubs: func(inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().DeleteTask(task.Queue, task.ID).Times(1).Return(fmt.Errorf("asynq: connection refused"))
+			},
+			status: http.StatusInternalServerError,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			inspector := mockwk.NewMockTaskInspector(ctrl)
+			tc.buildStubs(inspector)
+
+			server := newTestServerWithInspector(t, nil, inspector)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(tc.method, tc.path, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, admin.Email, admin.ID, admin.Role, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.status, recorder.Code)
+		})
+	}
+}
@@ -1,16 +1,21 @@
 package api
 
 import (
+	"database/sql"
+	"errors"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/hibiken/asynq"
 	"github.com/yashagw/event-management-api/db/model"
 	"github.com/yashagw/event-management-api/token"
+	"github.com/yashagw/event-management-api/worker"
 )
 
 type CreateTicketParams struct {
-	EventID  int64 `json:"event_id"`
-	Quantity int64 `json:"quantity"`
+	EventID  int64 `json:"event_id" binding:"required,min=1"`
+	Quantity int64 `json:"quantity" binding:"required,min=1"`
 }
 
 // CreateTicket  godoc
@@ -20,38 +25,165 @@ type CreateTicketParams struct {
 // @Produce      json
 // @Param        ticket body CreateTicketParams true "Ticket"
 // @Success      201 {object} model.Ticket
+// @Failure      400 {object} ResponseMessage "Invalid event or quantity"
+// @Failure      404 {object} ResponseMessage "Event not found"
+// @Failure      409 {object} ResponseMessage "Event not on sale or not enough tickets left"
 // @Router       /users/ticket [post]
 // @Security     Bearer
 func (server *Server) CreateTicket(context *gin.Context) {
 	payload := context.MustGet(authorizationPayloadKey).(*token.Payload)
-	userEmail := payload.Username
 
-	user, err := server.provider.GetUserByEmail(context, userEmail)
+	var params CreateTicketParams
+	if err := context.ShouldBindJSON(&params); err != nil {
+		context.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	ticket, err := server.provider.CreateTicketTx(context, model.CreateTicketTxParams{
+		CreateTicketParams: model.CreateTicketParams{
+			EventID:  params.EventID,
+			UserID:   payload.UserID,
+			Quantity: params.Quantity,
+		},
+		AfterCreate: func(ticket *model.Ticket) ([]model.CreateOutboxMessageParams, error) {
+			taskPayload := &worker.PayloadSendTicketPurchased{
+				UserID:   ticket.UserID,
+				TicketID: ticket.ID,
+			}
+			opts := []asynq.Option{
+				asynq.MaxRetry(10),
+				asynq.Timeout(10 * time.Second),
+				asynq.Queue(worker.QueueDefault),
+			}
+			message, err := worker.NewOutboxMessage(worker.TaskSendTicketPurchased, taskPayload, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return []model.CreateOutboxMessageParams{message}, nil
+		},
+	})
 	if err != nil {
-		context.JSON(http.StatusInternalServerError, errorResponse(err))
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			context.JSON(http.StatusNotFound, errorResponse(err))
+		case errors.Is(err, model.ErrEventCancelled), errors.Is(err, model.ErrEventNotPublished), errors.Is(err, model.ErrEventEnded):
+			context.JSON(http.StatusConflict, errorResponse(err))
+		case errors.Is(err, model.ErrNotEnoughTickets):
+			context.JSON(http.StatusConflict, errorResponse(err))
+		default:
+			context.JSON(http.StatusInternalServerError, errorResponse(err))
+		}
+		return
+	}
+
+	context.JSON(http.StatusOK, ticket)
+}
+
+type CancelTicketParams struct {
+	TicketID int64 `uri:"ticket_id" binding:"required,min=1"`
+}
+
+// CancelTicket  godoc
+// @Summary      Cancels tickets and refunds them.
+// @Description  Cancels tickets before the cancellation cutoff of their event, the tickets go back on sale.
+// @Tags         user
+// @Produce      json
+// @Param        ticket_id path int true "Ticket ID"
+// @Success      200 {object} model.Refund
+// @Failure      401 {object} ResponseMessage "Not Authorized"
+// @Failure      404 {object} ResponseMessage "Ticket not found"
+// @Failure      409 {object} ResponseMessage "Ticket already cancelled or cancellation closed"
+// @Router       /users/tickets/{ticket_id} [delete]
+// @Security     Bearer
+func (server *Server) CancelTicket(context *gin.Context) {
+	payload := context.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	var params CancelTicketParams
+	if err := context.ShouldBindUri(&params); err != nil {
+		context.JSON(http.StatusBadRequest, errorResponse(err))
 		return
 	}
 
-	if user.Role != model.UserRole_User {
-		context.JSON(http.StatusUnauthorized, gin.H{"message": "Not Authorized"})
+	refund, err := server.provider.CancelTicketTx(context, model.CancelTicketTxParams{
+		CancelTicketParams: model.CancelTicketParams{
+			UserID:   payload.UserID,
+			TicketID: params.TicketID,
+		},
+		AfterCancel: func(refund *model.Refund) ([]model.CreateOutboxMessageParams, error) {
+			taskPayload := &worker.PayloadSendTicketCancelled{
+				UserID:   refund.UserID,
+				TicketID: refund.TicketID,
+			}
+			opts := []asynq.Option{
+				asynq.MaxRetry(10),
+				asynq.Timeout(10 * time.Second),
+				asynq.Queue(worker.QueueDefault),
+			}
+			message, err := worker.NewOutboxMessage(worker.TaskSendTicketCancelled, taskPayload, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return []model.CreateOutboxMessageParams{message}, nil
+		},
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			context.JSON(http.StatusNotFound, errorResponse(err))
+		case errors.Is(err, model.ErrTicketAlreadyCancelled), errors.Is(err, model.ErrCancellationClosed):
+			context.JSON(http.StatusConflict, errorResponse(err))
+		default:
+			context.JSON(http.StatusInternalServerError, errorResponse(err))
+		}
 		return
 	}
 
-	var params model.CreateTicketParams
-	if err := context.ShouldBindJSON(&params); err != nil {
+	context.JSON(http.StatusOK, refund)
+}
+
+type ListTicketsParams struct {
+	Limit  int    `form:"limit" binding:"required,min=1,max=1000"`
+	Offset int    `form:"offset" binding:"min=0"`
+	Search string `form:"search"`
+	Period string `form:"period" binding:"omitempty,oneof=upcoming past"`
+	SortBy string `form:"sort_by" binding:"omitempty,oneof=purchase_date event_start"`
+}
+
+// ListTickets  godoc
+// @Summary      Lists bought tickets.
+// @Description  Lists the tickets of the user with their events, the latest purchase first unless sorted by event start.
+// @Tags         user
+// @Produce      json
+// @Param        limit query int true "Limit"
+// @Param        offset query int false "Offset"
+// @Param        search query string false "Event name or location"
+// @Param        period query string false "Period" Enums(upcoming, past)
+// @Param        sort_by query string false "Sort by" Enums(purchase_date, event_start)
+// @Success      200 {object} model.ListTicketsResponse
+// @Failure      401 {object} ResponseMessage "Not Authorized"
+// @Router       /users/tickets [get]
+// @Security     Bearer
+func (server *Server) ListTickets(context *gin.Context) {
+	payload := context.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	var params ListTicketsParams
+	if err := context.ShouldBindQuery(&params); err != nil {
 		context.JSON(http.StatusBadRequest, errorResponse(err))
 		return
 	}
 
-	ticket, err := server.provider.CreateTicket(context, model.CreateTicketParams{
-		EventID:  params.EventID,
-		UserID:   user.ID,
-		Quantity: params.Quantity,
+	tickets, err := server.provider.ListTickets(context, model.ListTicketsParams{
+		UserID: payload.UserID,
+		Search: params.Search,
+		Period: model.TicketPeriod(params.Period),
+		SortBy: model.TicketSortBy(params.SortBy),
+		Limit:  params.Limit,
+		Offset: params.Offset,
 	})
 	if err != nil {
 		context.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
-	context.JSON(http.StatusOK, ticket)
+	context.JSON(http.StatusOK, tickets)
 }
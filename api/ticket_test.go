@@ -2,7 +2,10 @@ package api
 
 import (
 	"bytes"
+	"context"
+	"database/sql"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -14,11 +17,15 @@ import (
 	mockdb "github.com/yashagw/event-management-api/db/mock"
 	"github.com/yashagw/event-management-api/db/model"
 	"github.com/yashagw/event-management-api/token"
+	"github.com/yashagw/event-management-api/worker"
 	mockwk "github.com/yashagw/event-management-api/worker/mock"
 )
 
 func TestCreateTicket(t *testing.T) {
 	user, _ := randomUser(t)
+	user.IsEmailVerified = true
+
+	unverifiedUser, _ := randomUser(t)
 
 	host, _ := randomUser(t)
 	host.Role = model.UserRole_Host
@@ -27,7 +34,7 @@ func TestCreateTicket(t *testing.T) {
 		name          string
 		body          gin.H
 		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
-		buildStubs    func(provider *mockdb.MockProvider)
+		buildStubs    func(provider *mockdb.MockProvider, distributor *mockwk.MockTaskDistributor)
 		checkResponse func(recorder *httptest.ResponseRecorder)
 	}{
 		{
@@ -37,22 +44,171 @@ func TestCreateTicket(t *testing.T) {
 				"quantity": 1,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, user.ID, user.Role, time.Minute)
 			},
-			buildStubs: func(provider *mockdb.MockProvider) {
+			buildStubs: func(provider *mockdb.MockProvider, distributor *mockwk.MockTaskDistributor) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(&user, nil)
+
 				arg := model.CreateTicketParams{
 					EventID:  1,
 					UserID:   user.ID,
 					Quantity: 1,
 				}
 
-				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(&user, nil)
-				provider.EXPECT().CreateTicket(gomock.Any(), gomock.Eq(arg)).Times(1).Return(&model.Ticket{}, nil)
+				ticket := &model.Ticket{ID: 1, UserID: user.ID, EventID: 1, Quantity: 1}
+				provider.EXPECT().CreateTicketTx(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(_ context.Context, txArg model.CreateTicketTxParams) (*model.Ticket, error) {
+						require.Equal(t, arg, txArg.CreateTicketParams)
+
+						// The confirmation is written to the outbox with the ticket
+						messages, err := txArg.AfterCreate(ticket)
+						require.NoError(t, err)
+						require.Len(t, messages, 1)
+						require.Equal(t, worker.TaskSendTicketPurchased, messages[0].TaskType)
+						require.JSONEq(t, `{"user_id":`+fmt.Sprint(user.ID)+`,"ticket_id":1}`, string(messages[0].Payload))
+
+						return ticket, nil
+					})
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
 			},
 		},
+		{
+			name: "Internal Error",
+			body: gin.H{
+				"event_id": 1,
+				"quantity": 1,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, user.ID, user.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider, distributor *mockwk.MockTaskDistributor) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(&user, nil)
+				provider.EXPECT().CreateTicketTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name: "Event Cancelled",
+			body: gin.H{
+				"event_id": 1,
+				"quantity": 1,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, user.ID, user.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider, distributor *mockwk.MockTaskDistributor) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(&user, nil)
+				provider.EXPECT().CreateTicketTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrEventCancelled)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "Event Ended",
+			body: gin.H{
+				"event_id": 1,
+				"quantity": 1,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, user.ID, user.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider, distributor *mockwk.MockTaskDistributor) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(&user, nil)
+				provider.EXPECT().CreateTicketTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrEventEnded)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "Not Enough Tickets",
+			body: gin.H{
+				"event_id": 1,
+				"quantity": 1,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, user.ID, user.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider, distributor *mockwk.MockTaskDistributor) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(&user, nil)
+				provider.EXPECT().CreateTicketTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrNotEnoughTickets)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "Event Not Found",
+			body: gin.H{
+				"event_id": 1,
+				"quantity": 1,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, user.ID, user.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider, distributor *mockwk.MockTaskDistributor) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(&user, nil)
+				provider.EXPECT().CreateTicketTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrNoRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Zero Quantity",
+			body: gin.H{
+				"event_id": 1,
+				"quantity": 0,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, user.ID, user.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider, distributor *mockwk.MockTaskDistributor) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(&user, nil)
+				provider.EXPECT().CreateTicketTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Negative Quantity",
+			body: gin.H{
+				"event_id": 1,
+				"quantity": -1,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, user.ID, user.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider, distributor *mockwk.MockTaskDistributor) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(&user, nil)
+				provider.EXPECT().CreateTicketTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Missing Event ID",
+			body: gin.H{
+				"quantity": 1,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, user.ID, user.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider, distributor *mockwk.MockTaskDistributor) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(&user, nil)
+				provider.EXPECT().CreateTicketTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
 		{
 			name: "No Authorization",
 			body: gin.H{
@@ -60,14 +216,31 @@ func TestCreateTicket(t *testing.T) {
 				"quantity": 1,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, host.Email, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, host.Email, host.ID, host.Role, time.Minute)
 			},
-			buildStubs: func(provider *mockdb.MockProvider) {
+			buildStubs: func(provider *mockdb.MockProvider, distributor *mockwk.MockTaskDistributor) {
 				provider.EXPECT().GetUserByEmail(gomock.Any(), host.Email).Times(1).Return(&host, nil)
-				provider.EXPECT().CreateTicket(gomock.Any(), gomock.Any()).Times(0)
+				provider.EXPECT().CreateTicketTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "Email Not Verified",
+			body: gin.H{
+				"event_id": 1,
+				"quantity": 1,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, unverifiedUser.Email, unverifiedUser.ID, unverifiedUser.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider, distributor *mockwk.MockTaskDistributor) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), unverifiedUser.Email).Times(1).Return(&unverifiedUser, nil)
+				provider.EXPECT().CreateTicketTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
 			},
 		},
 	}
@@ -77,11 +250,11 @@ func TestCreateTicket(t *testing.T) {
 			providerCtrl := gomock.NewController(t)
 			defer providerCtrl.Finish()
 			provider := mockdb.NewMockProvider(providerCtrl)
-			tc.buildStubs(provider)
 
 			redisCtrl := gomock.NewController(t)
 			defer redisCtrl.Finish()
 			distributor := mockwk.NewMockTaskDistributor(redisCtrl)
+			tc.buildStubs(provider, distributor)
 
 			server := newTestServer(t, provider, distributor)
 			recorder := httptest.NewRecorder()
@@ -98,3 +271,261 @@ func TestCreateTicket(t *testing.T) {
 		})
 	}
 }
+
+func TestCancelTicket(t *testing.T) {
+	user, _ := randomUser(t)
+	user.IsEmailVerified = true
+
+	host, _ := randomUser(t)
+	host.Role = model.UserRole_Host
+
+	testCases := []struct {
+		name          string
+		ticketID      int64
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(provider *mockdb.MockProvider)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			ticketID: 1,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, user.ID, user.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(&user, nil)
+
+				arg := model.CancelTicketParams{
+					UserID:   user.ID,
+					TicketID: 1,
+				}
+
+				refund := &model.Refund{ID: 1, TicketID: 1, UserID: user.ID, EventID: 1, Quantity: 2, Status: model.RefundStatus_Pending}
+				provider.EXPECT().CancelTicketTx(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(_ context.Context, txArg model.CancelTicketTxParams) (*model.Refund, error) {
+						require.Equal(t, arg, txArg.CancelTicketParams)
+
+						// The cancellation email is written to the outbox with the refund
+						messages, err := txArg.AfterCancel(refund)
+						require.NoError(t, err)
+						require.Len(t, messages, 1)
+						require.Equal(t, worker.TaskSendTicketCancelled, messages[0].TaskType)
+						require.JSONEq(t, `{"user_id":`+fmt.Sprint(user.ID)+`,"ticket_id":1}`, string(messages[0].Payload))
+
+						return refund, nil
+					})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var refund model.Refund
+				err := json.Unmarshal(recorder.Body.Bytes(), &refund)
+				require.NoError(t, err)
+				require.Equal(t, int64(1), refund.TicketID)
+				require.Equal(t, model.RefundStatus_Pending, refund.Status)
+			},
+		},
+		{
+			name:     "Not Found",
+			ticketID: 1,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, user.ID, user.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(&user, nil)
+				provider.EXPECT().CancelTicketTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrNoRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:     "Already Cancelled",
+			ticketID: 1,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, user.ID, user.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(&user, nil)
+				provider.EXPECT().CancelTicketTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrTicketAlreadyCancelled)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name:     "Cancellation Closed",
+			ticketID: 1,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, user.ID, user.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(&user, nil)
+				provider.EXPECT().CancelTicketTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrCancellationClosed)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name:     "Invalid ID",
+			ticketID: 0,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, user.ID, user.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(&user, nil)
+				provider.EXPECT().CancelTicketTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:     "No Authorization",
+			ticketID: 1,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, host.Email, host.ID, host.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), host.Email).Times(1).Return(&host, nil)
+				provider.EXPECT().CancelTicketTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			provider := mockdb.NewMockProvider(ctrl)
+			tc.buildStubs(provider)
+
+			server := newTestServer(t, provider, nil)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/users/tickets/%d", tc.ticketID)
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request, server.tokenMaker)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestListTickets(t *testing.T) {
+	user, _ := randomUser(t)
+	user.IsEmailVerified = true
+
+	event := randomEvent(t, user.ID)
+	tickets := []model.UserTicket{
+		{Ticket: model.Ticket{ID: 1, UserID: user.ID, EventID: event.ID, Quantity: 2}, Event: event},
+		{Ticket: model.Ticket{ID: 2, UserID: user.ID, EventID: event.ID, Quantity: 1}, Event: event, Cancelled: true},
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(provider *mockdb.MockProvider)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK",
+			query: "limit=5&offset=0&search=rock&period=upcoming&sort_by=event_start",
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(&user, nil)
+
+				arg := model.ListTicketsParams{
+					UserID: user.ID,
+					Search: "rock",
+					Period: model.TicketPeriod_Upcoming,
+					SortBy: model.TicketSortBy_EventStart,
+					Limit:  5,
+					Offset: 0,
+				}
+				provider.EXPECT().ListTickets(gomock.Any(), gomock.Eq(arg)).Times(1).
+					Return(&model.ListTicketsResponse{Records: tickets, NextOffset: 2}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res model.ListTicketsResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				require.Len(t, res.Records, 2)
+				require.Equal(t, 2, res.NextOffset)
+				require.Equal(t, event.Name, res.Records[0].Event.Name)
+				require.True(t, res.Records[1].Cancelled)
+			},
+		},
+		{
+			name:  "Defaults",
+			query: "limit=5",
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(&user, nil)
+				provider.EXPECT().ListTickets(gomock.Any(), gomock.Eq(model.ListTicketsParams{UserID: user.ID, Limit: 5})).Times(1).
+					Return(&model.ListTicketsResponse{}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:  "Invalid Period",
+			query: "limit=5&period=tomorrow",
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(&user, nil)
+				provider.EXPECT().ListTickets(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "Invalid Sort",
+			query: "limit=5&sort_by=price",
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(&user, nil)
+				provider.EXPECT().ListTickets(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "Internal Error",
+			query: "limit=5",
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(&user, nil)
+				provider.EXPECT().ListTickets(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			provider := mockdb.NewMockProvider(ctrl)
+			tc.buildStubs(provider)
+
+			server := newTestServer(t, provider, nil)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/users/tickets?"+tc.query, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Email, user.ID, user.Role, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
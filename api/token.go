@@ -0,0 +1,196 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/revocation"
+	"github.com/yashagw/event-management-api/token"
+)
+
+// RenewAccessTokenParams represents the parameters used to renew an access token.
+type RenewAccessTokenParams struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+// RenewAccessTokenResponse represents the response to a renew access token request
+type RenewAccessTokenResponse struct {
+	Token          string    `json:"token"`
+	TokenExpiresAt time.Time `json:"token_expires_at"`
+}
+
+// RenewAccessToken       godoc
+// @Summary      Renews an access token.
+// @Description  Swaps a valid refresh token for a new access token.
+// @Tags         user
+// @Produce      json
+// @Param        token body RenewAccessTokenParams true "Refresh token"
+// @Success      200 {object} RenewAccessTokenResponse
+// @Failure      401 {object} ResponseMessage "Not Authorized"
+// @Router       /tokens/renew_access [post]
+func (server *Server) RenewAccessToken(context *gin.Context) {
+	var req RenewAccessTokenParams
+	if err := context.ShouldBindJSON(&req); err != nil {
+		context.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	refreshPayload, err := server.tokenMaker.VerifyToken(req.RefreshToken)
+	if err != nil {
+		context.JSON(http.StatusUnauthorized, errorResponse(err))
+		return
+	}
+	if err := refreshPayload.CheckType(token.TokenTypeRefresh); err != nil {
+		context.JSON(http.StatusUnauthorized, errorResponse(err))
+		return
+	}
+
+	session, err := server.provider.GetSession(context, refreshPayload.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			context.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		context.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if session.IsBlocked {
+		context.JSON(http.StatusUnauthorized, errorResponse(errors.New("blocked session")))
+		return
+	}
+
+	if session.UserID != refreshPayload.UserID {
+		context.JSON(http.StatusUnauthorized, errorResponse(errors.New("incorrect session user")))
+		return
+	}
+
+	if session.RefreshToken != req.RefreshToken {
+		context.JSON(http.StatusUnauthorized, errorResponse(errors.New("mismatched session token")))
+		return
+	}
+
+	if time.Now().After(session.ExpiresAt) {
+		context.JSON(http.StatusUnauthorized, errorResponse(errors.New("expired session")))
+		return
+	}
+
+	// Load the user again so that the new access token carries the current role
+	user, err := server.provider.GetUserByEmail(context, refreshPayload.Username)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			context.JSON(http.StatusUnauthorized, errorResponse(err))
+			return
+		}
+		context.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	accessToken, accessPayload, err := server.tokenMaker.CreateToken(user.Email, user.ID, user.Role, token.TokenTypeAccess, server.config.AccessTokenDuration)
+	if err != nil {
+		context.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	err = server.provider.UpdateSessionAccessToken(context, model.UpdateSessionAccessTokenParams{
+		ID:            session.ID,
+		AccessTokenID: accessPayload.ID,
+	})
+	if err != nil {
+		context.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	// The new access token replaces the previous one of the session
+	err = revocation.RevokeSessionAccessToken(context, server.revocationList, *session, server.config.AccessTokenDuration)
+	if err != nil {
+		context.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := RenewAccessTokenResponse{
+		Token:          accessToken,
+		TokenExpiresAt: accessPayload.ExpiredAt,
+	}
+
+	context.JSON(http.StatusOK, res)
+}
+
+// LogoutUserParams represents the parameters used to log out a session.
+type LogoutUserParams struct {
+	SessionID string `json:"session_id" binding:"required,uuid"`
+}
+
+// LogoutUser       godoc
+// @Summary      Logs out a session.
+// @Description  Blocks the session so its refresh token can no longer be used and revokes its access tokens.
+// @Tags         user
+// @Produce      json
+// @Param        session body LogoutUserParams true "Session to log out"
+// @Success      200 {object} ResponseMessage "logged out"
+// @Failure      401 {object} ResponseMessage "Not Authorized"
+// @Failure      404 {object} ResponseMessage "Session not found"
+// @Router       /users/logout [post]
+// @Security     Bearer
+func (server *Server) LogoutUser(context *gin.Context) {
+	var req LogoutUserParams
+	if err := context.ShouldBindJSON(&req); err != nil {
+		context.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	payload := context.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	session, err := server.provider.BlockSession(context, model.BlockSessionParams{
+		ID:     uuid.MustParse(req.SessionID),
+		UserID: payload.UserID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			context.JSON(http.StatusNotFound, errorResponse(errors.New("session not found")))
+			return
+		}
+		context.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	err = revocation.RevokeSessions(context, server.revocationList, payload, []model.Session{*session}, server.config.AccessTokenDuration)
+	if err != nil {
+		context.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	context.JSON(http.StatusOK, ResponseMessage{Message: "logged out"})
+}
+
+// LogoutAllSessions       godoc
+// @Summary      Logs out every session of the user.
+// @Description  Blocks every session of the user and revokes their access tokens.
+// @Tags         user
+// @Produce      json
+// @Success      200 {object} ResponseMessage "logged out of all sessions"
+// @Failure      401 {object} ResponseMessage "Not Authorized"
+// @Router       /users/logout_all [post]
+// @Security     Bearer
+func (server *Server) LogoutAllSessions(context *gin.Context) {
+	payload := context.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	sessions, err := server.provider.BlockUserSessions(context, payload.UserID)
+	if err != nil {
+		context.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	err = revocation.RevokeSessions(context, server.revocationList, payload, sessions, server.config.AccessTokenDuration)
+	if err != nil {
+		context.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	context.JSON(http.StatusOK, ResponseMessage{Message: "logged out of all sessions"})
+}
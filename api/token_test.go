@@ -0,0 +1,341 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yashagw/event-management-api/db/mock"
+	"github.com/yashagw/event-management-api/db/model"
+	mockrv "github.com/yashagw/event-management-api/revocation/mock"
+	"github.com/yashagw/event-management-api/token"
+)
+
+func TestRenewAccessTokenAPI(t *testing.T) {
+	user, _ := randomUser(t)
+	previousAccessTokenID := uuid.New()
+
+	testcases := []struct {
+		name          string
+		duration      time.Duration
+		tokenType     token.TokenType
+		buildSession  func(refreshToken string, payload *token.Payload) *model.Session
+		buildStubs    func(provider *mockdb.MockProvider, revocationList *mockrv.MockList, session *model.Session)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			duration: time.Hour,
+			buildSession: func(refreshToken string, payload *token.Payload) *model.Session {
+				return &model.Session{ID: payload.ID, UserID: user.ID, RefreshToken: refreshToken, ExpiresAt: payload.ExpiredAt}
+			},
+			buildStubs: func(provider *mockdb.MockProvider, revocationList *mockrv.MockList, session *model.Session) {
+				provider.EXPECT().GetSession(gomock.Any(), session.ID).Times(1).Return(session, nil)
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(&user, nil)
+				provider.EXPECT().UpdateSessionAccessToken(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(_ interface{}, arg model.UpdateSessionAccessTokenParams) error {
+						require.Equal(t, session.ID, arg.ID)
+						return nil
+					})
+				revocationList.EXPECT().Revoke(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res RenewAccessTokenResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				require.NotEmpty(t, res.Token)
+			},
+		},
+		{
+			name:     "Replaces Access Token",
+			duration: time.Hour,
+			buildSession: func(refreshToken string, payload *token.Payload) *model.Session {
+				return &model.Session{
+					ID:            payload.ID,
+					UserID:        user.ID,
+					RefreshToken:  refreshToken,
+					ExpiresAt:     payload.ExpiredAt,
+					AccessTokenID: uuid.NullUUID{UUID: previousAccessTokenID, Valid: true},
+				}
+			},
+			buildStubs: func(provider *mockdb.MockProvider, revocationList *mockrv.MockList, session *model.Session) {
+				provider.EXPECT().GetSession(gomock.Any(), session.ID).Times(1).Return(session, nil)
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(&user, nil)
+				provider.EXPECT().UpdateSessionAccessToken(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+				revocationList.EXPECT().Revoke(gomock.Any(), previousAccessTokenID, user.ID, gomock.Any()).Times(1).Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "Expired Refresh Token",
+			duration: -time.Minute,
+			buildSession: func(refreshToken string, payload *token.Payload) *model.Session {
+				return &model.Session{ID: payload.ID}
+			},
+			buildStubs: func(provider *mockdb.MockProvider, revocationList *mockrv.MockList, session *model.Session) {
+				provider.EXPECT().GetSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:      "Access Token",
+			duration:  time.Hour,
+			tokenType: token.TokenTypeAccess,
+			buildSession: func(refreshToken string, payload *token.Payload) *model.Session {
+				return &model.Session{ID: payload.ID}
+			},
+			buildStubs: func(provider *mockdb.MockProvider, revocationList *mockrv.MockList, session *model.Session) {
+				provider.EXPECT().GetSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:     "Session Not Found",
+			duration: time.Hour,
+			buildSession: func(refreshToken string, payload *token.Payload) *model.Session {
+				return &model.Session{ID: payload.ID}
+			},
+			buildStubs: func(provider *mockdb.MockProvider, revocationList *mockrv.MockList, session *model.Session) {
+				provider.EXPECT().GetSession(gomock.Any(), session.ID).Times(1).Return(nil, sql.ErrNoRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:     "Blocked Session",
+			duration: time.Hour,
+			buildSession: func(refreshToken string, payload *token.Payload) *model.Session {
+				return &model.Session{ID: payload.ID, UserID: user.ID, RefreshToken: refreshToken, IsBlocked: true, ExpiresAt: payload.ExpiredAt}
+			},
+			buildStubs: func(provider *mockdb.MockProvider, revocationList *mockrv.MockList, session *model.Session) {
+				provider.EXPECT().GetSession(gomock.Any(), session.ID).Times(1).Return(session, nil)
+				provider.EXPECT().GetUserByEmail(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:     "Mismatched Refresh Token",
+			duration: time.Hour,
+			buildSession: func(refreshToken string, payload *token.Payload) *model.Session {
+				return &model.Session{ID: payload.ID, UserID: user.ID, RefreshToken: "other", ExpiresAt: payload.ExpiredAt}
+			},
+			buildStubs: func(provider *mockdb.MockProvider, revocationList *mockrv.MockList, session *model.Session) {
+				provider.EXPECT().GetSession(gomock.Any(), session.ID).Times(1).Return(session, nil)
+				provider.EXPECT().GetUserByEmail(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:     "Internal Error",
+			duration: time.Hour,
+			buildSession: func(refreshToken string, payload *token.Payload) *model.Session {
+				return &model.Session{ID: payload.ID}
+			},
+			buildStubs: func(provider *mockdb.MockProvider, revocationList *mockrv.MockList, session *model.Session) {
+				provider.EXPECT().GetSession(gomock.Any(), session.ID).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			providerCtrl := gomock.NewController(t)
+			defer providerCtrl.Finish()
+			provider := mockdb.NewMockProvider(providerCtrl)
+			revocationList := mockrv.NewMockList(providerCtrl)
+
+			server := newTestServerWithRevocationList(t, provider, nil, revocationList)
+
+			tokenType := tc.tokenType
+			if tokenType == "" {
+				tokenType = token.TokenTypeRefresh
+			}
+			refreshToken, payload, err := server.tokenMaker.CreateToken(user.Email, user.ID, user.Role, tokenType, tc.duration)
+			require.NoError(t, err)
+
+			tc.buildStubs(provider, revocationList, tc.buildSession(refreshToken, payload))
+
+			data, err := json.Marshal(gin.H{"refresh_token": refreshToken})
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(http.MethodPost, "/tokens/renew_access", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestLogoutUserAPI(t *testing.T) {
+	user, _ := randomUser(t)
+	sessionID := uuid.New()
+	sessionAccessTokenID := uuid.New()
+
+	testcases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(provider *mockdb.MockProvider, revocationList *mockrv.MockList)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"session_id": sessionID.String()},
+			buildStubs: func(provider *mockdb.MockProvider, revocationList *mockrv.MockList) {
+				arg := model.BlockSessionParams{ID: sessionID, UserID: user.ID}
+				provider.EXPECT().BlockSession(gomock.Any(), arg).Times(1).Return(&model.Session{
+					ID:            sessionID,
+					UserID:        user.ID,
+					AccessTokenID: uuid.NullUUID{UUID: sessionAccessTokenID, Valid: true},
+					IsBlocked:     true,
+				}, nil)
+				// The caller's token, and the refresh token and the latest access token of the session
+				revocationList.EXPECT().Revoke(gomock.Any(), gomock.Any(), user.ID, gomock.Any()).Times(1).Return(nil)
+				revocationList.EXPECT().Revoke(gomock.Any(), sessionID, user.ID, gomock.Any()).Times(1).Return(nil)
+				revocationList.EXPECT().Revoke(gomock.Any(), sessionAccessTokenID, user.ID, gomock.Any()).Times(1).Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Session Not Found",
+			body: gin.H{"session_id": sessionID.String()},
+			buildStubs: func(provider *mockdb.MockProvider, revocationList *mockrv.MockList) {
+				provider.EXPECT().BlockSession(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrNoRows)
+				revocationList.EXPECT().Revoke(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Session ID",
+			body: gin.H{"session_id": "invalid"},
+			buildStubs: func(provider *mockdb.MockProvider, revocationList *mockrv.MockList) {
+				provider.EXPECT().BlockSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Revoke Error",
+			body: gin.H{"session_id": sessionID.String()},
+			buildStubs: func(provider *mockdb.MockProvider, revocationList *mockrv.MockList) {
+				provider.EXPECT().BlockSession(gomock.Any(), gomock.Any()).Times(1).Return(&model.Session{ID: sessionID, UserID: user.ID}, nil)
+				revocationList.EXPECT().Revoke(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			provider := mockdb.NewMockProvider(ctrl)
+			revocationList := mockrv.NewMockList(ctrl)
+			revocationList.EXPECT().IsRevoked(gomock.Any(), gomock.Any()).Times(1).Return(false, nil)
+			tc.buildStubs(provider, revocationList)
+
+			server := newTestServerWithRevocationList(t, provider, nil, revocationList)
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(http.MethodPost, "/users/logout", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Email, user.ID, user.Role, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestLogoutAllSessionsAPI(t *testing.T) {
+	user, _ := randomUser(t)
+
+	testcases := []struct {
+		name          string
+		buildStubs    func(provider *mockdb.MockProvider, revocationList *mockrv.MockList)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(provider *mockdb.MockProvider, revocationList *mockrv.MockList) {
+				sessions := []model.Session{
+					{ID: uuid.New(), UserID: user.ID, AccessTokenID: uuid.NullUUID{UUID: uuid.New(), Valid: true}},
+					{ID: uuid.New(), UserID: user.ID, AccessTokenID: uuid.NullUUID{UUID: uuid.New(), Valid: true}},
+					{ID: uuid.New(), UserID: user.ID},
+				}
+				provider.EXPECT().BlockUserSessions(gomock.Any(), user.ID).Times(1).Return(sessions, nil)
+				// The caller's token, the three refresh tokens and the two access tokens
+				revocationList.EXPECT().Revoke(gomock.Any(), gomock.Any(), user.ID, gomock.Any()).Times(6).Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Error",
+			buildStubs: func(provider *mockdb.MockProvider, revocationList *mockrv.MockList) {
+				provider.EXPECT().BlockUserSessions(gomock.Any(), user.ID).Times(1).Return(nil, sql.ErrConnDone)
+				revocationList.EXPECT().Revoke(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			provider := mockdb.NewMockProvider(ctrl)
+			revocationList := mockrv.NewMockList(ctrl)
+			revocationList.EXPECT().IsRevoked(gomock.Any(), gomock.Any()).Times(1).Return(false, nil)
+			tc.buildStubs(provider, revocationList)
+
+			server := newTestServerWithRevocationList(t, provider, nil, revocationList)
+
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(http.MethodPost, "/users/logout_all", nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Email, user.ID, user.Role, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
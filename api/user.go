@@ -2,13 +2,16 @@ package api
 
 import (
 	"database/sql"
+	"errors"
 	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/hibiken/asynq"
 	"github.com/lib/pq"
 	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/token"
 	"github.com/yashagw/event-management-api/util"
 	"github.com/yashagw/event-management-api/worker"
 )
@@ -17,6 +20,7 @@ type UserResponse struct {
 	ID                int64     `json:"id"`
 	Name              string    `json:"name"`
 	Email             string    `json:"email"`
+	IsEmailVerified   bool      `json:"is_email_verified"`
 	CreatedAt         time.Time `json:"created_at"`
 	PasswordUpdatedAt time.Time `json:"password_updated_at"`
 }
@@ -49,12 +53,40 @@ func (server *Server) CreateUser(context *gin.Context) {
 		return
 	}
 
-	reqParams := model.CreateUserParams{
-		Name:           req.Name,
-		Email:          req.Email,
-		HashedPassword: hashedPassword,
+	secretCode, err := util.RandomSecret(32)
+	if err != nil {
+		context.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
 	}
-	user, err := server.provider.CreateUser(context, reqParams)
+
+	// The verification code and its email are written with the user, so
+	// retries of the task send the same code
+	arg := model.CreateUserTxParams{
+		CreateUserParams: model.CreateUserParams{
+			Name:           req.Name,
+			Email:          req.Email,
+			HashedPassword: hashedPassword,
+		},
+		SecretCode: secretCode,
+		AfterCreate: func(user *model.User, verifyEmail *model.VerifyEmail) ([]model.CreateOutboxMessageParams, error) {
+			taskPayload := &worker.PayloadSendEmailVerify{
+				Email:         user.Email,
+				VerifyEmailID: verifyEmail.ID,
+				NewUser:       true,
+			}
+			opts := []asynq.Option{
+				asynq.MaxRetry(3),
+				asynq.Timeout(10 * time.Second),
+				asynq.Queue(worker.QueueCritical),
+			}
+			message, err := worker.NewOutboxMessage(worker.TaskSendVerifyEmail, taskPayload, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return []model.CreateOutboxMessageParams{message}, nil
+		},
+	}
+	user, err := server.provider.CreateUserTx(context, arg)
 	if err != nil {
 		if pqErr, ok := err.(*pq.Error); ok {
 			switch pqErr.Code.Name() {
@@ -67,23 +99,11 @@ func (server *Server) CreateUser(context *gin.Context) {
 		return
 	}
 
-	taskPayload := worker.PayloadSendEmailVerify{
-		Email: user.Email,
-	}
-	opts := []asynq.Option{
-		asynq.MaxRetry(3),
-		asynq.Timeout(10 * time.Second),
-		asynq.Queue(worker.QueueCritical),
-	}
-	err = server.distributor.DistributeTaskSendEmailVerify(context, &taskPayload, opts...)
-	if err != nil {
-		context.JSON(http.StatusInternalServerError, errorResponse(err))
-	}
-
 	res := UserResponse{
 		ID:                user.ID,
 		Name:              user.Name,
 		Email:             user.Email,
+		IsEmailVerified:   user.IsEmailVerified,
 		CreatedAt:         user.CreatedAt,
 		PasswordUpdatedAt: user.PasswordUpdatedAt,
 	}
@@ -99,8 +119,12 @@ type LoginUserParams struct {
 
 // LoginUserResponse represents the response to a login user request
 type LoginUserResponse struct {
-	Token string       `json:"token"`
-	User  UserResponse `json:"user"`
+	SessionID             uuid.UUID    `json:"session_id"`
+	Token                 string       `json:"token"`
+	TokenExpiresAt        time.Time    `json:"token_expires_at"`
+	RefreshToken          string       `json:"refresh_token"`
+	RefreshTokenExpiresAt time.Time    `json:"refresh_token_expires_at"`
+	User                  UserResponse `json:"user"`
 }
 
 // LoginUser              godoc
@@ -134,18 +158,44 @@ func (server *Server) LoginUser(context *gin.Context) {
 		return
 	}
 
-	accessToken, _, err := server.tokenMaker.CreateToken(user.Email, server.config.AccessTokenDuration)
+	accessToken, accessPayload, err := server.tokenMaker.CreateToken(user.Email, user.ID, user.Role, token.TokenTypeAccess, server.config.AccessTokenDuration)
+	if err != nil {
+		context.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(user.Email, user.ID, user.Role, token.TokenTypeRefresh, server.config.RefreshTokenDuration)
+	if err != nil {
+		context.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	session, err := server.provider.CreateSession(context, model.CreateSessionParams{
+		ID:            refreshPayload.ID,
+		UserID:        user.ID,
+		RefreshToken:  refreshToken,
+		AccessTokenID: accessPayload.ID,
+		UserAgent:     context.Request.UserAgent(),
+		ClientIP:      context.ClientIP(),
+		IsBlocked:     false,
+		ExpiresAt:     refreshPayload.ExpiredAt,
+	})
 	if err != nil {
 		context.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
 	res := LoginUserResponse{
-		Token: accessToken,
+		SessionID:             session.ID,
+		Token:                 accessToken,
+		TokenExpiresAt:        accessPayload.ExpiredAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: refreshPayload.ExpiredAt,
 		User: UserResponse{
 			ID:                user.ID,
 			Name:              user.Name,
 			Email:             user.Email,
+			IsEmailVerified:   user.IsEmailVerified,
 			CreatedAt:         user.CreatedAt,
 			PasswordUpdatedAt: user.PasswordUpdatedAt,
 		},
@@ -153,3 +203,105 @@ func (server *Server) LoginUser(context *gin.Context) {
 
 	context.JSON(http.StatusOK, res)
 }
+
+// VerifyEmailParams represents the parameters used to verify an email address.
+type VerifyEmailParams struct {
+	EmailID    int64  `form:"email_id" binding:"required,min=1"`
+	SecretCode string `form:"secret_code" binding:"required"`
+}
+
+// VerifyEmailResponse represents the response to a verify email request
+type VerifyEmailResponse struct {
+	IsVerified bool `json:"is_verified"`
+}
+
+// VerifyEmail             godoc
+// @Summary      Verifies the email of a user.
+// @Description  Checks the code sent by email and marks the email of the user as verified.
+// @Tags         user
+// @Produce      json
+// @Param        email_id query int true "Email ID"
+// @Param        secret_code query string true "Secret code"
+// @Success      200 {object} VerifyEmailResponse
+// @Failure      400 {object} ResponseMessage "Invalid or expired code"
+// @Router       /users/verify_email [get]
+func (server *Server) VerifyEmail(context *gin.Context) {
+	var req VerifyEmailParams
+	if err := context.ShouldBindQuery(&req); err != nil {
+		context.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	user, err := server.provider.VerifyEmail(context, model.VerifyEmailParams{
+		EmailID:    req.EmailID,
+		SecretCode: req.SecretCode,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			context.JSON(http.StatusBadRequest, ResponseMessage{Message: "invalid or expired code"})
+			return
+		}
+		context.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	context.JSON(http.StatusOK, VerifyEmailResponse{IsVerified: user.IsEmailVerified})
+}
+
+// ResendVerifyEmail       godoc
+// @Summary      Sends a new verification email.
+// @Description  Sends a new code to verify the email of the user. A new code can be asked for once every VERIFY_EMAIL_INTERVAL.
+// @Tags         user
+// @Produce      json
+// @Success      200 {object} ResponseMessage "verification email sent"
+// @Failure      401 {object} ResponseMessage "Not Authorized"
+// @Failure      409 {object} ResponseMessage "Email already verified"
+// @Failure      429 {object} ResponseMessage "Asked for too soon"
+// @Router       /users/resend_verify_email [post]
+// @Security     Bearer
+func (server *Server) ResendVerifyEmail(context *gin.Context) {
+	payload := context.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	secretCode, err := util.RandomSecret(32)
+	if err != nil {
+		context.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	_, err = server.provider.ResendVerifyEmailTx(context, model.ResendVerifyEmailTxParams{
+		UserID:     payload.UserID,
+		SecretCode: secretCode,
+		Interval:   server.config.VerifyEmailInterval,
+		AfterCreate: func(verifyEmail *model.VerifyEmail) ([]model.CreateOutboxMessageParams, error) {
+			taskPayload := &worker.PayloadSendEmailVerify{
+				Email:         verifyEmail.Email,
+				VerifyEmailID: verifyEmail.ID,
+			}
+			opts := []asynq.Option{
+				asynq.MaxRetry(3),
+				asynq.Timeout(10 * time.Second),
+				asynq.Queue(worker.QueueCritical),
+			}
+			message, err := worker.NewOutboxMessage(worker.TaskSendVerifyEmail, taskPayload, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return []model.CreateOutboxMessageParams{message}, nil
+		},
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			context.JSON(http.StatusNotFound, errorResponse(err))
+		case errors.Is(err, model.ErrEmailAlreadyVerified):
+			context.JSON(http.StatusConflict, errorResponse(err))
+		case errors.Is(err, model.ErrVerifyEmailTooSoon):
+			context.JSON(http.StatusTooManyRequests, errorResponse(err))
+		default:
+			context.JSON(http.StatusInternalServerError, errorResponse(err))
+		}
+		return
+	}
+
+	context.JSON(http.StatusOK, ResponseMessage{Message: "verification email sent"})
+}
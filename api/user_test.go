@@ -18,17 +18,20 @@ import (
 	"github.com/stretchr/testify/require"
 	mockdb "github.com/yashagw/event-management-api/db/mock"
 	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/token"
 	"github.com/yashagw/event-management-api/util"
+	"github.com/yashagw/event-management-api/worker"
 	mockwk "github.com/yashagw/event-management-api/worker/mock"
 )
 
-type eqCreateUserParamsMatcher struct {
+type eqCreateUserTxParamsMatcher struct {
 	arg      model.CreateUserParams
 	password string
+	user     model.User
 }
 
-func (e eqCreateUserParamsMatcher) Matches(x interface{}) bool {
-	arg, ok := x.(model.CreateUserParams)
+func (e eqCreateUserTxParamsMatcher) Matches(x interface{}) bool {
+	arg, ok := x.(model.CreateUserTxParams)
 	if !ok {
 		return false
 	}
@@ -39,15 +42,46 @@ func (e eqCreateUserParamsMatcher) Matches(x interface{}) bool {
 	}
 
 	e.arg.HashedPassword = arg.HashedPassword
-	return reflect.DeepEqual(e.arg, arg)
+	if !reflect.DeepEqual(e.arg, arg.CreateUserParams) {
+		return false
+	}
+
+	// The verification code and its email must be written with the user
+	if arg.SecretCode == "" {
+		return false
+	}
+
+	verifyEmail := &model.VerifyEmail{
+		ID:         util.RandomInt(1, 1000),
+		UserID:     e.user.ID,
+		Email:      e.user.Email,
+		SecretCode: arg.SecretCode,
+	}
+	messages, err := arg.AfterCreate(&e.user, verifyEmail)
+	if err != nil || len(messages) != 1 {
+		return false
+	}
+
+	var payload worker.PayloadSendEmailVerify
+	err = json.Unmarshal(messages[0].Payload, &payload)
+	if err != nil {
+		return false
+	}
+
+	return messages[0].TaskType == worker.TaskSendVerifyEmail &&
+		messages[0].Queue == worker.QueueCritical &&
+		messages[0].TaskID != "" &&
+		payload.Email == e.user.Email &&
+		payload.VerifyEmailID == verifyEmail.ID &&
+		payload.NewUser
 }
 
-func (e eqCreateUserParamsMatcher) String() string {
+func (e eqCreateUserTxParamsMatcher) String() string {
 	return fmt.Sprintf("matches arg %v and password %v", e.arg, e.password)
 }
 
-func EqCreateUserParams(arg model.CreateUserParams, password string) gomock.Matcher {
-	return eqCreateUserParamsMatcher{arg, password}
+func EqCreateUserTxParams(arg model.CreateUserParams, password string, user model.User) gomock.Matcher {
+	return eqCreateUserTxParamsMatcher{arg, password, user}
 }
 
 func randomUser(t *testing.T) (user model.User, password string) {
@@ -83,9 +117,42 @@ func TestLoginUserAPI(t *testing.T) {
 			},
 			buildStubs: func(provider *mockdb.MockProvider, worker *mockwk.MockTaskDistributor) {
 				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(&user, nil)
+				provider.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(_ interface{}, arg model.CreateSessionParams) (*model.Session, error) {
+						require.Equal(t, user.ID, arg.UserID)
+						require.NotEmpty(t, arg.RefreshToken)
+						return &model.Session{
+							ID:           arg.ID,
+							UserID:       arg.UserID,
+							RefreshToken: arg.RefreshToken,
+							ExpiresAt:    arg.ExpiresAt,
+						}, nil
+					})
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res LoginUserResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				require.NotEmpty(t, res.SessionID)
+				require.NotEmpty(t, res.Token)
+				require.NotEmpty(t, res.RefreshToken)
+				require.True(t, res.RefreshTokenExpiresAt.After(res.TokenExpiresAt))
+			},
+		},
+		{
+			name: "Create Session Error",
+			body: gin.H{
+				"email":    user.Email,
+				"password": password,
+			},
+			buildStubs: func(provider *mockdb.MockProvider, worker *mockwk.MockTaskDistributor) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(&user, nil)
+				provider.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
 			},
 		},
 		{
@@ -191,10 +258,8 @@ func TestCreateUserAPI(t *testing.T) {
 					Email: user.Email,
 				}
 
-				provider.EXPECT().CreateUser(gomock.Any(), EqCreateUserParams(arg, password)).
+				provider.EXPECT().CreateUserTx(gomock.Any(), EqCreateUserTxParams(arg, password, user)).
 					Times(1).Return(&user, nil)
-				worker.EXPECT().DistributeTaskSendEmailVerify(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-					Times(1).Return(nil)
 
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
@@ -210,7 +275,7 @@ func TestCreateUserAPI(t *testing.T) {
 				"password": password,
 			},
 			buildStubs: func(provider *mockdb.MockProvider, worker *mockwk.MockTaskDistributor) {
-				provider.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Times(1).
+				provider.EXPECT().CreateUserTx(gomock.Any(), gomock.Any()).Times(1).
 					Return(nil, sql.ErrConnDone)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
@@ -225,7 +290,7 @@ func TestCreateUserAPI(t *testing.T) {
 				"password": password,
 			},
 			buildStubs: func(provider *mockdb.MockProvider, worker *mockwk.MockTaskDistributor) {
-				provider.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Times(1).
+				provider.EXPECT().CreateUserTx(gomock.Any(), gomock.Any()).Times(1).
 					Return(nil, &pq.Error{Code: "23505"})
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
@@ -240,7 +305,7 @@ func TestCreateUserAPI(t *testing.T) {
 				"password": password,
 			},
 			buildStubs: func(provider *mockdb.MockProvider, worker *mockwk.MockTaskDistributor) {
-				provider.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Times(0)
+				provider.EXPECT().CreateUserTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
@@ -254,7 +319,7 @@ func TestCreateUserAPI(t *testing.T) {
 				"password": "short",
 			},
 			buildStubs: func(provider *mockdb.MockProvider, worker *mockwk.MockTaskDistributor) {
-				provider.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Times(0)
+				provider.EXPECT().CreateUserTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
@@ -301,3 +366,186 @@ func requireBodyMatchUser(t *testing.T, body *bytes.Buffer, user model.User) {
 	require.Equal(t, user.Email, gotUser.Email)
 	require.Empty(t, gotUser.HashedPassword)
 }
+
+func TestVerifyEmailAPI(t *testing.T) {
+	user, _ := randomUser(t)
+
+	testcases := []struct {
+		name          string
+		query         string
+		buildStubs    func(provider *mockdb.MockProvider)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK",
+			query: "email_id=1&secret_code=abc",
+			buildStubs: func(provider *mockdb.MockProvider) {
+				arg := model.VerifyEmailParams{EmailID: 1, SecretCode: "abc"}
+				verifiedUser := user
+				verifiedUser.IsEmailVerified = true
+				provider.EXPECT().VerifyEmail(gomock.Any(), arg).Times(1).Return(&verifiedUser, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, `{"is_verified":true}`, recorder.Body.String())
+			},
+		},
+		{
+			name:  "Invalid Code",
+			query: "email_id=1&secret_code=abc",
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().VerifyEmail(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrNoRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "Missing Code",
+			query: "email_id=1",
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().VerifyEmail(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "Internal Error",
+			query: "email_id=1&secret_code=abc",
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().VerifyEmail(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			providerCtrl := gomock.NewController(t)
+			defer providerCtrl.Finish()
+			provider := mockdb.NewMockProvider(providerCtrl)
+			tc.buildStubs(provider)
+
+			server := newTestServer(t, provider, nil)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/users/verify_email?"+tc.query, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestResendVerifyEmailAPI(t *testing.T) {
+	user, _ := randomUser(t)
+
+	testcases := []struct {
+		name          string
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(provider *mockdb.MockProvider)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, user.ID, user.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().ResendVerifyEmailTx(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(_ interface{}, arg model.ResendVerifyEmailTxParams) (*model.VerifyEmail, error) {
+						require.Equal(t, user.ID, arg.UserID)
+						require.NotEmpty(t, arg.SecretCode)
+						require.Equal(t, time.Minute, arg.Interval)
+
+						verifyEmail := &model.VerifyEmail{ID: 1, UserID: user.ID, Email: user.Email, SecretCode: arg.SecretCode}
+						messages, err := arg.AfterCreate(verifyEmail)
+						require.NoError(t, err)
+						require.Len(t, messages, 1)
+						require.Equal(t, worker.TaskSendVerifyEmail, messages[0].TaskType)
+
+						// The task sends the code that was just created
+						var payload worker.PayloadSendEmailVerify
+						require.NoError(t, json.Unmarshal(messages[0].Payload, &payload))
+						require.Equal(t, user.Email, payload.Email)
+						require.Equal(t, verifyEmail.ID, payload.VerifyEmailID)
+
+						return verifyEmail, nil
+					})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "No Authorization",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().ResendVerifyEmailTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Already Verified",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, user.ID, user.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().ResendVerifyEmailTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrEmailAlreadyVerified)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "Too Soon",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, user.ID, user.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().ResendVerifyEmailTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrVerifyEmailTooSoon)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Error",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, user.ID, user.Role, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().ResendVerifyEmailTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			providerCtrl := gomock.NewController(t)
+			defer providerCtrl.Finish()
+			provider := mockdb.NewMockProvider(providerCtrl)
+			tc.buildStubs(provider)
+
+			server := newTestServer(t, provider, nil)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodPost, "/users/resend_verify_email", nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request, server.tokenMaker)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "revoked_tokens";
+
+DROP INDEX IF EXISTS "sessions_user_id_idx";
+
+ALTER TABLE "sessions" DROP COLUMN IF EXISTS "access_token_id";
@@ -0,0 +1,12 @@
+ALTER TABLE "sessions" ADD COLUMN "access_token_id" uuid;
+
+CREATE TABLE IF NOT EXISTS "revoked_tokens" (
+  "id" uuid PRIMARY KEY,
+  "user_id" bigint NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "revoked_tokens" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+
+CREATE INDEX ON "sessions" ("user_id");
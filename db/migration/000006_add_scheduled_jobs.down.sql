@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS "outbox_dispatched_at_idx";
+DROP INDEX IF EXISTS "verify_emails_expired_at_idx";
+DROP INDEX IF EXISTS "revoked_tokens_expires_at_idx";
+DROP INDEX IF EXISTS "sessions_expires_at_idx";
+DROP INDEX IF EXISTS "user_host_requests_status_created_at_idx";
+DROP INDEX IF EXISTS "tickets_event_id_idx";
+
+ALTER TABLE "events" DROP COLUMN IF EXISTS "reminder_sent_at";
+ALTER TABLE "events" DROP COLUMN IF EXISTS "completed_at";
@@ -0,0 +1,12 @@
+ALTER TABLE "events" ADD COLUMN "completed_at" timestamptz;
+ALTER TABLE "events" ADD COLUMN "reminder_sent_at" timestamptz;
+
+CREATE INDEX ON "events" ("end_date") WHERE "completed_at" IS NULL;
+CREATE INDEX ON "events" ("start_date") WHERE "reminder_sent_at" IS NULL;
+CREATE INDEX ON "tickets" ("event_id");
+
+CREATE INDEX ON "user_host_requests" ("status", "created_at");
+CREATE INDEX ON "sessions" ("expires_at");
+CREATE INDEX ON "revoked_tokens" ("expires_at");
+CREATE INDEX ON "verify_emails" ("expired_at");
+CREATE INDEX ON "outbox" ("dispatched_at") WHERE "dispatched_at" IS NOT NULL;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "refunds";
+
+ALTER TABLE "events" DROP COLUMN IF EXISTS "cancellation_cutoff_hours";
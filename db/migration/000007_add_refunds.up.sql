@@ -0,0 +1,20 @@
+ALTER TABLE "events" ADD COLUMN "cancellation_cutoff_hours" int NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS "refunds" (
+  "id" bigserial PRIMARY KEY,
+  "ticket_id" bigint UNIQUE NOT NULL,
+  "user_id" bigint NOT NULL,
+  "event_id" bigint NOT NULL,
+  "quantity" int NOT NULL,
+  "status" int NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "refunds" ADD FOREIGN KEY ("ticket_id") REFERENCES "tickets" ("id");
+
+ALTER TABLE "refunds" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id");
+
+ALTER TABLE "refunds" ADD FOREIGN KEY ("event_id") REFERENCES "events" ("id");
+
+CREATE INDEX ON "refunds" ("status");
@@ -0,0 +1,2 @@
+ALTER TABLE "events" DROP COLUMN IF EXISTS "cancelled_at";
+ALTER TABLE "events" DROP COLUMN IF EXISTS "status";
@@ -0,0 +1,4 @@
+ALTER TABLE "events" ADD COLUMN "status" int NOT NULL DEFAULT 0;
+ALTER TABLE "events" ADD COLUMN "cancelled_at" timestamptz;
+
+CREATE INDEX ON "events" ("status");
@@ -0,0 +1 @@
+UPDATE "events" SET "status" = CASE "status" WHEN 4 THEN 1 ELSE 0 END;
@@ -0,0 +1,2 @@
+-- Events that existed so far were public, cancelled moves from 1 to 4
+UPDATE "events" SET "status" = CASE "status" WHEN 1 THEN 4 ELSE 1 END;
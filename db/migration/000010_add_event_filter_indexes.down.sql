@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS "events_left_tickets_idx";
+DROP INDEX IF EXISTS "events_location_trgm_idx";
+DROP INDEX IF EXISTS "events_duration_idx";
+DROP INDEX IF EXISTS "events_end_date_all_idx";
+DROP INDEX IF EXISTS "events_dates_idx";
@@ -0,0 +1,11 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX "events_dates_idx" ON "events" ("start_date", "end_date");
+
+CREATE INDEX "events_end_date_all_idx" ON "events" ("end_date");
+
+CREATE INDEX "events_duration_idx" ON "events" (("end_date"::date - "start_date"::date));
+
+CREATE INDEX "events_location_trgm_idx" ON "events" USING gin ("location" gin_trgm_ops);
+
+CREATE INDEX "events_left_tickets_idx" ON "events" ("left_tickets");
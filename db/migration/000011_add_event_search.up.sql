@@ -0,0 +1,7 @@
+ALTER TABLE "events" ADD COLUMN "search" tsvector GENERATED ALWAYS AS (
+  setweight(to_tsvector('english', "name"), 'A') ||
+  setweight(to_tsvector('english', "location"), 'B') ||
+  setweight(to_tsvector('english', "description"), 'C')
+) STORED;
+
+CREATE INDEX "events_search_idx" ON "events" USING gin ("search");
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS "outbox_pending_idx";
+
+CREATE INDEX IF NOT EXISTS "outbox_id_idx" ON "outbox" ("id") WHERE "dispatched_at" IS NULL;
+
+ALTER TABLE "outbox" DROP COLUMN IF EXISTS "next_attempt_at";
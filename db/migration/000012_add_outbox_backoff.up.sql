@@ -0,0 +1,5 @@
+ALTER TABLE "outbox" ADD COLUMN "next_attempt_at" timestamptz NOT NULL DEFAULT (now());
+
+DROP INDEX IF EXISTS "outbox_id_idx";
+
+CREATE INDEX "outbox_pending_idx" ON "outbox" ("next_attempt_at", "id") WHERE "dispatched_at" IS NULL;
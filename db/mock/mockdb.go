@@ -8,8 +8,10 @@ import (
 	context "context"
 	sql "database/sql"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
 	model "github.com/yashagw/event-management-api/db/model"
 )
 
@@ -37,11 +39,12 @@ func (m *MockProvider) EXPECT() *MockProviderMockRecorder {
 }
 
 // ApproveDisapproveRequestToBecomeHost mocks base method.
-func (m *MockProvider) ApproveDisapproveRequestToBecomeHost(arg0 context.Context, arg1 model.ApproveDisapproveRequestToBecomeHostParams) error {
+func (m *MockProvider) ApproveDisapproveRequestToBecomeHost(arg0 context.Context, arg1 model.ApproveDisapproveRequestToBecomeHostParams) (*model.UserHostRequest, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ApproveDisapproveRequestToBecomeHost", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*model.UserHostRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // ApproveDisapproveRequestToBecomeHost indicates an expected call of ApproveDisapproveRequestToBecomeHost.
@@ -50,6 +53,111 @@ func (mr *MockProviderMockRecorder) ApproveDisapproveRequestToBecomeHost(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveDisapproveRequestToBecomeHost", reflect.TypeOf((*MockProvider)(nil).ApproveDisapproveRequestToBecomeHost), arg0, arg1)
 }
 
+// ApproveDisapproveRequestToBecomeHostTx mocks base method.
+func (m *MockProvider) ApproveDisapproveRequestToBecomeHostTx(arg0 context.Context, arg1 model.ApproveDisapproveRequestToBecomeHostTxParams) (*model.UserHostRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveDisapproveRequestToBecomeHostTx", arg0, arg1)
+	ret0, _ := ret[0].(*model.UserHostRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveDisapproveRequestToBecomeHostTx indicates an expected call of ApproveDisapproveRequestToBecomeHostTx.
+func (mr *MockProviderMockRecorder) ApproveDisapproveRequestToBecomeHostTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveDisapproveRequestToBecomeHostTx", reflect.TypeOf((*MockProvider)(nil).ApproveDisapproveRequestToBecomeHostTx), arg0, arg1)
+}
+
+// BlockSession mocks base method.
+func (m *MockProvider) BlockSession(arg0 context.Context, arg1 model.BlockSessionParams) (*model.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockSession", arg0, arg1)
+	ret0, _ := ret[0].(*model.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockSession indicates an expected call of BlockSession.
+func (mr *MockProviderMockRecorder) BlockSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockSession", reflect.TypeOf((*MockProvider)(nil).BlockSession), arg0, arg1)
+}
+
+// BlockUserSessions mocks base method.
+func (m *MockProvider) BlockUserSessions(arg0 context.Context, arg1 int64) ([]model.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockUserSessions", arg0, arg1)
+	ret0, _ := ret[0].([]model.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockUserSessions indicates an expected call of BlockUserSessions.
+func (mr *MockProviderMockRecorder) BlockUserSessions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockUserSessions", reflect.TypeOf((*MockProvider)(nil).BlockUserSessions), arg0, arg1)
+}
+
+// CancelEvent mocks base method.
+func (m *MockProvider) CancelEvent(arg0 context.Context, arg1 model.CancelEventParams) (*model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelEvent", arg0, arg1)
+	ret0, _ := ret[0].(*model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelEvent indicates an expected call of CancelEvent.
+func (mr *MockProviderMockRecorder) CancelEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelEvent", reflect.TypeOf((*MockProvider)(nil).CancelEvent), arg0, arg1)
+}
+
+// CancelEventTx mocks base method.
+func (m *MockProvider) CancelEventTx(arg0 context.Context, arg1 model.CancelEventTxParams) (*model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelEventTx", arg0, arg1)
+	ret0, _ := ret[0].(*model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelEventTx indicates an expected call of CancelEventTx.
+func (mr *MockProviderMockRecorder) CancelEventTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelEventTx", reflect.TypeOf((*MockProvider)(nil).CancelEventTx), arg0, arg1)
+}
+
+// CancelTicket mocks base method.
+func (m *MockProvider) CancelTicket(arg0 context.Context, arg1 model.CancelTicketParams) (*model.Refund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelTicket", arg0, arg1)
+	ret0, _ := ret[0].(*model.Refund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelTicket indicates an expected call of CancelTicket.
+func (mr *MockProviderMockRecorder) CancelTicket(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTicket", reflect.TypeOf((*MockProvider)(nil).CancelTicket), arg0, arg1)
+}
+
+// CancelTicketTx mocks base method.
+func (m *MockProvider) CancelTicketTx(arg0 context.Context, arg1 model.CancelTicketTxParams) (*model.Refund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelTicketTx", arg0, arg1)
+	ret0, _ := ret[0].(*model.Refund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelTicketTx indicates an expected call of CancelTicketTx.
+func (mr *MockProviderMockRecorder) CancelTicketTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTicketTx", reflect.TypeOf((*MockProvider)(nil).CancelTicketTx), arg0, arg1)
+}
+
 // Close mocks base method.
 func (m *MockProvider) Close() error {
 	m.ctrl.T.Helper()
@@ -64,6 +172,21 @@ func (mr *MockProviderMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockProvider)(nil).Close))
 }
 
+// CompleteEvents mocks base method.
+func (m *MockProvider) CompleteEvents(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteEvents", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteEvents indicates an expected call of CompleteEvents.
+func (mr *MockProviderMockRecorder) CompleteEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteEvents", reflect.TypeOf((*MockProvider)(nil).CompleteEvents), arg0, arg1)
+}
+
 // CreateEvent mocks base method.
 func (m *MockProvider) CreateEvent(arg0 context.Context, arg1 model.CreateEventParams) (*model.Event, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +217,21 @@ func (mr *MockProviderMockRecorder) CreateRequestToBecomeHost(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRequestToBecomeHost", reflect.TypeOf((*MockProvider)(nil).CreateRequestToBecomeHost), arg0, arg1)
 }
 
+// CreateSession mocks base method.
+func (m *MockProvider) CreateSession(arg0 context.Context, arg1 model.CreateSessionParams) (*model.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", arg0, arg1)
+	ret0, _ := ret[0].(*model.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockProviderMockRecorder) CreateSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockProvider)(nil).CreateSession), arg0, arg1)
+}
+
 // CreateTicket mocks base method.
 func (m *MockProvider) CreateTicket(arg0 context.Context, arg1 model.CreateTicketParams) (*model.Ticket, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +247,21 @@ func (mr *MockProviderMockRecorder) CreateTicket(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicket", reflect.TypeOf((*MockProvider)(nil).CreateTicket), arg0, arg1)
 }
 
+// CreateTicketTx mocks base method.
+func (m *MockProvider) CreateTicketTx(arg0 context.Context, arg1 model.CreateTicketTxParams) (*model.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTicketTx", arg0, arg1)
+	ret0, _ := ret[0].(*model.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTicketTx indicates an expected call of CreateTicketTx.
+func (mr *MockProviderMockRecorder) CreateTicketTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicketTx", reflect.TypeOf((*MockProvider)(nil).CreateTicketTx), arg0, arg1)
+}
+
 // CreateUser mocks base method.
 func (m *MockProvider) CreateUser(arg0 context.Context, arg1 model.CreateUserParams) (*model.User, error) {
 	m.ctrl.T.Helper()
@@ -124,6 +277,36 @@ func (mr *MockProviderMockRecorder) CreateUser(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockProvider)(nil).CreateUser), arg0, arg1)
 }
 
+// CreateUserTx mocks base method.
+func (m *MockProvider) CreateUserTx(arg0 context.Context, arg1 model.CreateUserTxParams) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserTx", arg0, arg1)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserTx indicates an expected call of CreateUserTx.
+func (mr *MockProviderMockRecorder) CreateUserTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserTx", reflect.TypeOf((*MockProvider)(nil).CreateUserTx), arg0, arg1)
+}
+
+// CreateVerifyEmail mocks base method.
+func (m *MockProvider) CreateVerifyEmail(arg0 context.Context, arg1 model.CreateVerifyEmailParams) (*model.VerifyEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVerifyEmail", arg0, arg1)
+	ret0, _ := ret[0].(*model.VerifyEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVerifyEmail indicates an expected call of CreateVerifyEmail.
+func (mr *MockProviderMockRecorder) CreateVerifyEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVerifyEmail", reflect.TypeOf((*MockProvider)(nil).CreateVerifyEmail), arg0, arg1)
+}
+
 // DB mocks base method.
 func (m *MockProvider) DB() *sql.DB {
 	m.ctrl.T.Helper()
@@ -138,6 +321,21 @@ func (mr *MockProviderMockRecorder) DB() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DB", reflect.TypeOf((*MockProvider)(nil).DB))
 }
 
+// DeleteDispatchedOutboxMessages mocks base method.
+func (m *MockProvider) DeleteDispatchedOutboxMessages(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDispatchedOutboxMessages", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDispatchedOutboxMessages indicates an expected call of DeleteDispatchedOutboxMessages.
+func (mr *MockProviderMockRecorder) DeleteDispatchedOutboxMessages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDispatchedOutboxMessages", reflect.TypeOf((*MockProvider)(nil).DeleteDispatchedOutboxMessages), arg0, arg1)
+}
+
 // DeleteEvent mocks base method.
 func (m *MockProvider) DeleteEvent(arg0 context.Context, arg1 int64) error {
 	m.ctrl.T.Helper()
@@ -152,6 +350,51 @@ func (mr *MockProviderMockRecorder) DeleteEvent(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvent", reflect.TypeOf((*MockProvider)(nil).DeleteEvent), arg0, arg1)
 }
 
+// DeleteExpiredRevokedTokens mocks base method.
+func (m *MockProvider) DeleteExpiredRevokedTokens(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredRevokedTokens", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpiredRevokedTokens indicates an expected call of DeleteExpiredRevokedTokens.
+func (mr *MockProviderMockRecorder) DeleteExpiredRevokedTokens(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredRevokedTokens", reflect.TypeOf((*MockProvider)(nil).DeleteExpiredRevokedTokens), arg0, arg1)
+}
+
+// DeleteExpiredSessions mocks base method.
+func (m *MockProvider) DeleteExpiredSessions(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredSessions", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpiredSessions indicates an expected call of DeleteExpiredSessions.
+func (mr *MockProviderMockRecorder) DeleteExpiredSessions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredSessions", reflect.TypeOf((*MockProvider)(nil).DeleteExpiredSessions), arg0, arg1)
+}
+
+// DeleteExpiredVerifyEmails mocks base method.
+func (m *MockProvider) DeleteExpiredVerifyEmails(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredVerifyEmails", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpiredVerifyEmails indicates an expected call of DeleteExpiredVerifyEmails.
+func (mr *MockProviderMockRecorder) DeleteExpiredVerifyEmails(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredVerifyEmails", reflect.TypeOf((*MockProvider)(nil).DeleteExpiredVerifyEmails), arg0, arg1)
+}
+
 // DeleteRequestToBecomeHost mocks base method.
 func (m *MockProvider) DeleteRequestToBecomeHost(arg0 context.Context, arg1 int64) error {
 	m.ctrl.T.Helper()
@@ -194,6 +437,36 @@ func (mr *MockProviderMockRecorder) DeleteUser(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockProvider)(nil).DeleteUser), arg0, arg1)
 }
 
+// DispatchOutboxMessages mocks base method.
+func (m *MockProvider) DispatchOutboxMessages(arg0 context.Context, arg1 model.DispatchOutboxMessagesParams) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DispatchOutboxMessages", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DispatchOutboxMessages indicates an expected call of DispatchOutboxMessages.
+func (mr *MockProviderMockRecorder) DispatchOutboxMessages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DispatchOutboxMessages", reflect.TypeOf((*MockProvider)(nil).DispatchOutboxMessages), arg0, arg1)
+}
+
+// ExpireHostRequests mocks base method.
+func (m *MockProvider) ExpireHostRequests(arg0 context.Context, arg1 model.ExpireHostRequestsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireHostRequests", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireHostRequests indicates an expected call of ExpireHostRequests.
+func (mr *MockProviderMockRecorder) ExpireHostRequests(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireHostRequests", reflect.TypeOf((*MockProvider)(nil).ExpireHostRequests), arg0, arg1)
+}
+
 // GetEvent mocks base method.
 func (m *MockProvider) GetEvent(arg0 context.Context, arg1 model.GetEventParams) (*model.Event, error) {
 	m.ctrl.T.Helper()
@@ -209,6 +482,21 @@ func (mr *MockProviderMockRecorder) GetEvent(arg0, arg1 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvent", reflect.TypeOf((*MockProvider)(nil).GetEvent), arg0, arg1)
 }
 
+// GetEventSalesStats mocks base method.
+func (m *MockProvider) GetEventSalesStats(arg0 context.Context, arg1 int64) (*model.EventSalesStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventSalesStats", arg0, arg1)
+	ret0, _ := ret[0].(*model.EventSalesStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventSalesStats indicates an expected call of GetEventSalesStats.
+func (mr *MockProviderMockRecorder) GetEventSalesStats(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventSalesStats", reflect.TypeOf((*MockProvider)(nil).GetEventSalesStats), arg0, arg1)
+}
+
 // GetRequestToBecomeHost mocks base method.
 func (m *MockProvider) GetRequestToBecomeHost(arg0 context.Context, arg1 int64) (*model.UserHostRequest, error) {
 	m.ctrl.T.Helper()
@@ -224,6 +512,21 @@ func (mr *MockProviderMockRecorder) GetRequestToBecomeHost(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequestToBecomeHost", reflect.TypeOf((*MockProvider)(nil).GetRequestToBecomeHost), arg0, arg1)
 }
 
+// GetSession mocks base method.
+func (m *MockProvider) GetSession(arg0 context.Context, arg1 uuid.UUID) (*model.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", arg0, arg1)
+	ret0, _ := ret[0].(*model.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockProviderMockRecorder) GetSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockProvider)(nil).GetSession), arg0, arg1)
+}
+
 // GetTicket mocks base method.
 func (m *MockProvider) GetTicket(arg0 context.Context, arg1 model.GetTicketParams) (*model.Ticket, error) {
 	m.ctrl.T.Helper()
@@ -254,6 +557,66 @@ func (mr *MockProviderMockRecorder) GetUserByEmail(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockProvider)(nil).GetUserByEmail), arg0, arg1)
 }
 
+// GetUserByID mocks base method.
+func (m *MockProvider) GetUserByID(arg0 context.Context, arg1 int64) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", arg0, arg1)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByID indicates an expected call of GetUserByID.
+func (mr *MockProviderMockRecorder) GetUserByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockProvider)(nil).GetUserByID), arg0, arg1)
+}
+
+// GetVerifyEmail mocks base method.
+func (m *MockProvider) GetVerifyEmail(arg0 context.Context, arg1 int64) (*model.VerifyEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVerifyEmail", arg0, arg1)
+	ret0, _ := ret[0].(*model.VerifyEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVerifyEmail indicates an expected call of GetVerifyEmail.
+func (mr *MockProviderMockRecorder) GetVerifyEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVerifyEmail", reflect.TypeOf((*MockProvider)(nil).GetVerifyEmail), arg0, arg1)
+}
+
+// IsTokenRevoked mocks base method.
+func (m *MockProvider) IsTokenRevoked(arg0 context.Context, arg1 uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTokenRevoked", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsTokenRevoked indicates an expected call of IsTokenRevoked.
+func (mr *MockProviderMockRecorder) IsTokenRevoked(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTokenRevoked", reflect.TypeOf((*MockProvider)(nil).IsTokenRevoked), arg0, arg1)
+}
+
+// ListEventTickets mocks base method.
+func (m *MockProvider) ListEventTickets(arg0 context.Context, arg1 model.ListEventTicketsParams) (*model.ListEventTicketsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEventTickets", arg0, arg1)
+	ret0, _ := ret[0].(*model.ListEventTicketsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventTickets indicates an expected call of ListEventTickets.
+func (mr *MockProviderMockRecorder) ListEventTickets(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventTickets", reflect.TypeOf((*MockProvider)(nil).ListEventTickets), arg0, arg1)
+}
+
 // ListEvents mocks base method.
 func (m *MockProvider) ListEvents(arg0 context.Context, arg1 model.ListEventsParams) (*model.ListEventsResponse, error) {
 	m.ctrl.T.Helper()
@@ -284,6 +647,80 @@ func (mr *MockProviderMockRecorder) ListPendingRequests(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingRequests", reflect.TypeOf((*MockProvider)(nil).ListPendingRequests), arg0, arg1)
 }
 
+// ListTickets mocks base method.
+func (m *MockProvider) ListTickets(arg0 context.Context, arg1 model.ListTicketsParams) (*model.ListTicketsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTickets", arg0, arg1)
+	ret0, _ := ret[0].(*model.ListTicketsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTickets indicates an expected call of ListTickets.
+func (mr *MockProviderMockRecorder) ListTickets(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTickets", reflect.TypeOf((*MockProvider)(nil).ListTickets), arg0, arg1)
+}
+
+// QueueEventReminders mocks base method.
+func (m *MockProvider) QueueEventReminders(arg0 context.Context, arg1 model.QueueEventRemindersParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueueEventReminders", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueueEventReminders indicates an expected call of QueueEventReminders.
+func (mr *MockProviderMockRecorder) QueueEventReminders(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueEventReminders", reflect.TypeOf((*MockProvider)(nil).QueueEventReminders), arg0, arg1)
+}
+
+// ResendVerifyEmailTx mocks base method.
+func (m *MockProvider) ResendVerifyEmailTx(arg0 context.Context, arg1 model.ResendVerifyEmailTxParams) (*model.VerifyEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResendVerifyEmailTx", arg0, arg1)
+	ret0, _ := ret[0].(*model.VerifyEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResendVerifyEmailTx indicates an expected call of ResendVerifyEmailTx.
+func (mr *MockProviderMockRecorder) ResendVerifyEmailTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendVerifyEmailTx", reflect.TypeOf((*MockProvider)(nil).ResendVerifyEmailTx), arg0, arg1)
+}
+
+// RevokeToken mocks base method.
+func (m *MockProvider) RevokeToken(arg0 context.Context, arg1 model.RevokeTokenParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeToken", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeToken indicates an expected call of RevokeToken.
+func (mr *MockProviderMockRecorder) RevokeToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeToken", reflect.TypeOf((*MockProvider)(nil).RevokeToken), arg0, arg1)
+}
+
+// SearchEvents mocks base method.
+func (m *MockProvider) SearchEvents(arg0 context.Context, arg1 model.SearchEventsParams) (*model.SearchEventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchEvents", arg0, arg1)
+	ret0, _ := ret[0].(*model.SearchEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchEvents indicates an expected call of SearchEvents.
+func (mr *MockProviderMockRecorder) SearchEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchEvents", reflect.TypeOf((*MockProvider)(nil).SearchEvents), arg0, arg1)
+}
+
 // Tx mocks base method.
 func (m *MockProvider) Tx() *sql.Tx {
 	m.ctrl.T.Helper()
@@ -297,3 +734,77 @@ func (mr *MockProviderMockRecorder) Tx() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tx", reflect.TypeOf((*MockProvider)(nil).Tx))
 }
+
+// UpdateEvent mocks base method.
+func (m *MockProvider) UpdateEvent(arg0 context.Context, arg1 model.UpdateEventParams) (*model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEvent", arg0, arg1)
+	ret0, _ := ret[0].(*model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEvent indicates an expected call of UpdateEvent.
+func (mr *MockProviderMockRecorder) UpdateEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEvent", reflect.TypeOf((*MockProvider)(nil).UpdateEvent), arg0, arg1)
+}
+
+// UpdateEventStatus mocks base method.
+func (m *MockProvider) UpdateEventStatus(arg0 context.Context, arg1 model.UpdateEventStatusParams) (*model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEventStatus", arg0, arg1)
+	ret0, _ := ret[0].(*model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEventStatus indicates an expected call of UpdateEventStatus.
+func (mr *MockProviderMockRecorder) UpdateEventStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEventStatus", reflect.TypeOf((*MockProvider)(nil).UpdateEventStatus), arg0, arg1)
+}
+
+// UpdateEventTx mocks base method.
+func (m *MockProvider) UpdateEventTx(arg0 context.Context, arg1 model.UpdateEventTxParams) (*model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEventTx", arg0, arg1)
+	ret0, _ := ret[0].(*model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEventTx indicates an expected call of UpdateEventTx.
+func (mr *MockProviderMockRecorder) UpdateEventTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEventTx", reflect.TypeOf((*MockProvider)(nil).UpdateEventTx), arg0, arg1)
+}
+
+// UpdateSessionAccessToken mocks base method.
+func (m *MockProvider) UpdateSessionAccessToken(arg0 context.Context, arg1 model.UpdateSessionAccessTokenParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSessionAccessToken", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSessionAccessToken indicates an expected call of UpdateSessionAccessToken.
+func (mr *MockProviderMockRecorder) UpdateSessionAccessToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSessionAccessToken", reflect.TypeOf((*MockProvider)(nil).UpdateSessionAccessToken), arg0, arg1)
+}
+
+// VerifyEmail mocks base method.
+func (m *MockProvider) VerifyEmail(arg0 context.Context, arg1 model.VerifyEmailParams) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", arg0, arg1)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockProviderMockRecorder) VerifyEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockProvider)(nil).VerifyEmail), arg0, arg1)
+}
@@ -1,6 +1,84 @@
 package model
 
-import "time"
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/yashagw/event-management-api/pb"
+)
+
+var (
+	// ErrEventNotOwned is returned when a host acts on an event of another host
+	ErrEventNotOwned = errors.New("event does not belong to the host")
+	// ErrEventTicketsSold is returned when changing more than the description of an event with tickets sold
+	ErrEventTicketsSold = errors.New("only the description can be changed once tickets are sold")
+	// ErrInvalidEventDates is returned when an event would end before it starts
+	ErrInvalidEventDates = errors.New("end_date must be after start_date")
+	// ErrEventCancelled is returned when changing or buying tickets for a cancelled event
+	ErrEventCancelled = errors.New("event is cancelled")
+	// ErrEventEnded is returned when publishing, changing, cancelling or buying tickets for an event that is over
+	ErrEventEnded = errors.New("event has already ended")
+	// ErrEventNotPublished is returned when buying tickets for a draft event
+	ErrEventNotPublished = errors.New("event is not published")
+	// ErrInvalidStatusTransition is returned when an event cannot move to the requested status
+	ErrInvalidStatusTransition = errors.New("invalid event status transition")
+)
+
+// EventStatus is the lifecycle of an event. Ongoing is never stored, a
+// published event is ongoing between its start and end date and completed
+// afterwards. Completed is also stored once the completion job has run.
+type EventStatus int
+
+const (
+	EventStatus_Draft EventStatus = iota
+	EventStatus_Published
+	EventStatus_Ongoing
+	EventStatus_Completed
+	EventStatus_Cancelled
+)
+
+// Implement the Scan method for EventStatus
+// It is used by the sql package to convert a value from the database into an EventStatus
+func (es *EventStatus) Scan(value interface{}) error {
+	if value == nil {
+		*es = 0
+		return nil
+	}
+
+	intValue, ok := value.(int64)
+	if !ok {
+		return fmt.Errorf("cannot scan value into EventStatus")
+	}
+
+	*es = EventStatus(intValue)
+	return nil
+}
+
+// Implement the Value method for EventStatus
+// It is used by the sql package to convert an EventStatus into a value that can be stored in the database
+func (es EventStatus) Value() (driver.Value, error) {
+	return int64(es), nil
+}
+
+// Convert model.EventStatus to pb.EventStatus
+func (es EventStatus) ToProto() pb.EventStatus {
+	switch es {
+	case EventStatus_Draft:
+		return pb.EventStatus_EventStatus_Draft
+	case EventStatus_Published:
+		return pb.EventStatus_EventStatus_Published
+	case EventStatus_Ongoing:
+		return pb.EventStatus_EventStatus_Ongoing
+	case EventStatus_Completed:
+		return pb.EventStatus_EventStatus_Completed
+	case EventStatus_Cancelled:
+		return pb.EventStatus_EventStatus_Cancelled
+	default:
+		return pb.EventStatus_EventStatus_Draft
+	}
+}
 
 // Event represents an event in the database
 type Event struct {
@@ -14,6 +92,9 @@ type Event struct {
 	StartDate    time.Time `json:"start_date"`
 	EndDate      time.Time `json:"end_date"`
 	CreatedAt    time.Time `json:"created_at"`
+	// Tickets can be cancelled until this many hours before StartDate
+	CancellationCutoffHours int64       `json:"cancellation_cutoff_hours"`
+	Status                  EventStatus `json:"status"`
 }
 
 type CreateEventParams struct {
@@ -24,19 +105,179 @@ type CreateEventParams struct {
 	TotalTickets int64     `json:"total_tickets"`
 	StartDate    time.Time `json:"start_date"`
 	EndDate      time.Time `json:"end_date"`
+	// Tickets can be cancelled until this many hours before StartDate
+	CancellationCutoffHours int64 `json:"cancellation_cutoff_hours"`
 }
 
 type GetEventParams struct {
 	EventID int64 `json:"event_id"`
+	// Published hides draft events
+	Published bool `json:"published"`
+}
+
+// EventPeriod narrows events down by when they happen
+type EventPeriod string
+
+const (
+	// EventPeriod_Ongoing keeps events that have started and not ended yet
+	EventPeriod_Ongoing EventPeriod = "ongoing"
+	// EventPeriod_Upcoming keeps events that have not started yet
+	EventPeriod_Upcoming EventPeriod = "upcoming"
+	// EventPeriod_Past keeps events that have ended
+	EventPeriod_Past EventPeriod = "past"
+)
+
+// EventFilters narrow down a list of events, zero values do not filter
+type EventFilters struct {
+	Period EventPeriod `json:"period"`
+	// DurationDays keeps events that span this many calendar days
+	DurationDays int `json:"duration_days"`
+	// Month keeps events that take place at least partly in the month that starts at Month
+	Month time.Time `json:"month"`
+	// City matches the location, case insensitive
+	City           string `json:"city"`
+	MinTicketsLeft int64  `json:"min_tickets_left"`
 }
 
 type ListEventsParams struct {
 	HostID int64 `json:"host_id"`
-	Limit  int   `json:"limit"`
-	Offset int   `json:"offset"`
+	// Published hides draft events
+	Published bool `json:"published"`
+	EventFilters
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
 }
 
 type ListEventsResponse struct {
 	Records    []Event `json:"records"`
 	NextOffset int     `json:"next_offset"`
 }
+
+type SearchEventsParams struct {
+	// Query is matched against the name, location and description, every word
+	// has to match a whole word or the start of one
+	Query  string `json:"query"`
+	HostID int64  `json:"host_id"`
+	// Published hides draft events
+	Published bool `json:"published"`
+	EventFilters
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+}
+
+// EventSearchResult is an event that matches a search
+type EventSearchResult struct {
+	Event
+	// Rank is higher for better matches, matches in the name count the most
+	Rank float64 `json:"rank"`
+	// Headline is a snippet of the event with the matches wrapped in <b></b>
+	Headline string `json:"headline"`
+}
+
+type SearchEventsResponse struct {
+	Records    []EventSearchResult `json:"records"`
+	NextOffset int                 `json:"next_offset"`
+}
+
+// UpdateEventParams represents the changes to an event, nil fields are kept.
+// Only the description can be changed once tickets are sold.
+type UpdateEventParams struct {
+	EventID      int64      `json:"event_id"`
+	HostID       int64      `json:"host_id"`
+	Description  *string    `json:"description"`
+	Name         *string    `json:"name"`
+	Location     *string    `json:"location"`
+	TotalTickets *int64     `json:"total_tickets"`
+	StartDate    *time.Time `json:"start_date"`
+	EndDate      *time.Time `json:"end_date"`
+}
+
+// UpdateEventTxParams represents parameters to update an event together with its outbox messages
+type UpdateEventTxParams struct {
+	UpdateEventParams
+	// AfterUpdate returns the messages that tell the ticket holders about the
+	// change, it is not called when nothing changed or nobody holds tickets
+	AfterUpdate func(event *Event, userIDs []int64) ([]CreateOutboxMessageParams, error)
+}
+
+// UpdateEventStatusParams represents parameters to publish or unpublish an event
+type UpdateEventStatusParams struct {
+	EventID int64       `json:"event_id"`
+	HostID  int64       `json:"host_id"`
+	Status  EventStatus `json:"status"`
+}
+
+type CancelEventParams struct {
+	EventID int64 `json:"event_id"`
+	HostID  int64 `json:"host_id"`
+}
+
+// CancelEventTxParams represents parameters to cancel an event together with its outbox messages
+type CancelEventTxParams struct {
+	CancelEventParams
+	// AfterCancel returns the messages that tell the ticket holders about the
+	// cancellation, it is not called when nobody holds tickets
+	AfterCancel func(event *Event, userIDs []int64) ([]CreateOutboxMessageParams, error)
+}
+
+// QueueEventRemindersParams represents parameters to remind ticket holders of upcoming events
+type QueueEventRemindersParams struct {
+	// Events starting between Now and StartsBefore are reminded once
+	Now          time.Time
+	StartsBefore time.Time
+	// BuildMessages returns the outbox messages that remind the holders of an event
+	BuildMessages func(event *Event, userIDs []int64) ([]CreateOutboxMessageParams, error)
+}
+
+// CancellationDeadline is the last moment at which tickets for the event can be cancelled
+func (event *Event) CancellationDeadline() time.Time {
+	return event.StartDate.Add(-time.Duration(event.CancellationCutoffHours) * time.Hour)
+}
+
+// CurrentStatus returns the status of the event at now, a published event is
+// ongoing from its start date and completed from its end date
+func (event *Event) CurrentStatus(now time.Time) EventStatus {
+	if event.Status != EventStatus_Published {
+		return event.Status
+	}
+	switch {
+	case !now.Before(event.EndDate):
+		return EventStatus_Completed
+	case !now.Before(event.StartDate):
+		return EventStatus_Ongoing
+	default:
+		return EventStatus_Published
+	}
+}
+
+// ValidateTransition checks that the event can move to status at now. Drafts
+// are published before they end, published events are unpublished before
+// they start, and events are cancelled before they end.
+func (event *Event) ValidateTransition(status EventStatus, now time.Time) error {
+	current := event.CurrentStatus(now)
+	if current == EventStatus_Cancelled {
+		return ErrEventCancelled
+	}
+
+	switch status {
+	case EventStatus_Published:
+		if current != EventStatus_Draft {
+			return ErrInvalidStatusTransition
+		}
+		if !now.Before(event.EndDate) {
+			return ErrEventEnded
+		}
+	case EventStatus_Draft:
+		if current != EventStatus_Published {
+			return ErrInvalidStatusTransition
+		}
+	case EventStatus_Cancelled:
+		if !now.Before(event.EndDate) {
+			return ErrEventEnded
+		}
+	default:
+		return ErrInvalidStatusTransition
+	}
+
+	return nil
+}
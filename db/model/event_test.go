@@ -0,0 +1,103 @@
+package model
+
+import (
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventStatus(t *testing.T) {
+	var es EventStatus
+
+	// Test Scan method with valid value
+	err := es.Scan(int64(EventStatus_Cancelled))
+	assert.NoError(t, err)
+	assert.Equal(t, EventStatus_Cancelled, es)
+
+	// Test Scan method with nil value
+	err = es.Scan(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, EventStatus_Draft, es) // Default value should be EventStatus_Draft
+
+	// Test Scan method with unsupported value type
+	err = es.Scan("invalid")
+	assert.Error(t, err)
+	assert.Equal(t, EventStatus_Draft, es) // Value should remain unchanged
+
+	// Test Value method
+	val, err := EventStatus_Published.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, driver.Value(int64(EventStatus_Published)), val)
+}
+
+func TestEventCurrentStatus(t *testing.T) {
+	now := time.Now()
+	event := Event{
+		StartDate: now.Add(time.Hour),
+		EndDate:   now.Add(2 * time.Hour),
+	}
+
+	testCases := []struct {
+		name   string
+		status EventStatus
+		now    time.Time
+		want   EventStatus
+	}{
+		{"Draft", EventStatus_Draft, now.Add(90 * time.Minute), EventStatus_Draft},
+		{"Published", EventStatus_Published, now, EventStatus_Published},
+		{"Ongoing", EventStatus_Published, now.Add(time.Hour), EventStatus_Ongoing},
+		{"Completed", EventStatus_Published, now.Add(2 * time.Hour), EventStatus_Completed},
+		{"Cancelled", EventStatus_Cancelled, now.Add(3 * time.Hour), EventStatus_Cancelled},
+		{"Stored Completed", EventStatus_Completed, now, EventStatus_Completed},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			event.Status = tc.status
+			assert.Equal(t, tc.want, event.CurrentStatus(tc.now))
+		})
+	}
+}
+
+func TestEventValidateTransition(t *testing.T) {
+	now := time.Now()
+	event := Event{
+		StartDate: now.Add(time.Hour),
+		EndDate:   now.Add(2 * time.Hour),
+	}
+
+	testCases := []struct {
+		name string
+		from EventStatus
+		to   EventStatus
+		now  time.Time
+		want error
+	}{
+		{name: "Publish", from: EventStatus_Draft, to: EventStatus_Published, now: now},
+		{name: "Publish Twice", from: EventStatus_Published, to: EventStatus_Published, now: now, want: ErrInvalidStatusTransition},
+		{name: "Publish Ended", from: EventStatus_Draft, to: EventStatus_Published, now: now.Add(3 * time.Hour), want: ErrEventEnded},
+		{name: "Unpublish", from: EventStatus_Published, to: EventStatus_Draft, now: now},
+		{name: "Unpublish Ongoing", from: EventStatus_Published, to: EventStatus_Draft, now: now.Add(time.Hour), want: ErrInvalidStatusTransition},
+		{name: "Unpublish Draft", from: EventStatus_Draft, to: EventStatus_Draft, now: now, want: ErrInvalidStatusTransition},
+		{name: "Cancel Draft", from: EventStatus_Draft, to: EventStatus_Cancelled, now: now},
+		{name: "Cancel Ongoing", from: EventStatus_Published, to: EventStatus_Cancelled, now: now.Add(time.Hour)},
+		{name: "Cancel Completed", from: EventStatus_Published, to: EventStatus_Cancelled, now: now.Add(2 * time.Hour), want: ErrEventEnded},
+		{name: "Cancel Twice", from: EventStatus_Cancelled, to: EventStatus_Cancelled, now: now, want: ErrEventCancelled},
+		{name: "Publish Cancelled", from: EventStatus_Cancelled, to: EventStatus_Published, now: now, want: ErrEventCancelled},
+		{name: "Computed Status", from: EventStatus_Draft, to: EventStatus_Ongoing, now: now, want: ErrInvalidStatusTransition},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			event.Status = tc.from
+			err := event.ValidateTransition(tc.to, tc.now)
+			if tc.want == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tc.want)
+			}
+		})
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"time"
+
+	"github.com/yashagw/event-management-api/pb"
 )
 
 type UserHostRequestStatus int
@@ -38,6 +40,20 @@ func (es UserHostRequestStatus) Value() (driver.Value, error) {
 	return int64(es), nil
 }
 
+// Convert model.UserHostRequestStatus to pb.UserHostRequestStatus
+func (status UserHostRequestStatus) ToProto() pb.UserHostRequestStatus {
+	switch status {
+	case UserHostRequestStatus_Pending:
+		return pb.UserHostRequestStatus_UserHostRequestStatus_Pending
+	case UserHostRequestStatus_Rejected:
+		return pb.UserHostRequestStatus_UserHostRequestStatus_Rejected
+	case UserHostRequestStatus_Approved:
+		return pb.UserHostRequestStatus_UserHostRequestStatus_Approved
+	default:
+		return pb.UserHostRequestStatus_UserHostRequestStatus_Pending
+	}
+}
+
 // UserHostRequest represents a request to become a host in the database
 type UserHostRequest struct {
 	ID          int64                 `json:"id"`
@@ -63,3 +79,20 @@ type ApproveDisapproveRequestToBecomeHostParams struct {
 	RequestID   int64 `json:"request_id"`
 	ModeratorID int64 `json:"moderator_id"`
 }
+
+// ApproveDisapproveRequestToBecomeHostTxParams represents parameters to decide on a
+// request together with its outbox messages
+type ApproveDisapproveRequestToBecomeHostTxParams struct {
+	ApproveDisapproveRequestToBecomeHostParams
+	// AfterUpdate returns the messages to write to the outbox in the same transaction
+	AfterUpdate func(request *UserHostRequest) ([]CreateOutboxMessageParams, error)
+}
+
+// ExpireHostRequestsParams represents parameters to delete stale requests so
+// that their users can ask again
+type ExpireHostRequestsParams struct {
+	// PendingBefore expires pending requests created before it
+	PendingBefore time.Time `json:"pending_before"`
+	// RejectedBefore expires rejected requests decided before it
+	RejectedBefore time.Time `json:"rejected_before"`
+}
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// OutboxMessage is a task written in the same transaction as the change that
+// caused it. A relay enqueues it once that transaction has committed.
+type OutboxMessage struct {
+	ID            int64          `json:"id"`
+	TaskID        string         `json:"task_id"`
+	TaskType      string         `json:"task_type"`
+	Payload       []byte         `json:"payload"`
+	Queue         string         `json:"queue"`
+	MaxRetry      int            `json:"max_retry"`
+	Timeout       time.Duration  `json:"timeout"`
+	Attempts      int            `json:"attempts"`
+	LastError     sql.NullString `json:"last_error"`
+	NextAttemptAt time.Time      `json:"next_attempt_at"`
+	CreatedAt     time.Time      `json:"created_at"`
+	DispatchedAt  sql.NullTime   `json:"dispatched_at"`
+}
+
+// CreateOutboxMessageParams represents a task to write to the outbox
+type CreateOutboxMessageParams struct {
+	TaskID   string        `json:"task_id"`
+	TaskType string        `json:"task_type"`
+	Payload  []byte        `json:"payload"`
+	Queue    string        `json:"queue"`
+	MaxRetry int           `json:"max_retry"`
+	Timeout  time.Duration `json:"timeout"`
+}
+
+// DispatchOutboxMessagesParams represents parameters to relay a batch of outbox messages
+type DispatchOutboxMessagesParams struct {
+	Limit int
+	// MaxAttempts is how many times a message is tried, it is left in the outbox
+	// with its last error once they are used up
+	MaxAttempts int
+	// RetryDelay returns how long to wait before trying a message again after
+	// its attempts-th failed attempt
+	RetryDelay func(attempts int) time.Duration
+	// Dispatch publishes one message, the message stays in the outbox when it returns an error
+	Dispatch func(message *OutboxMessage) error
+}
@@ -0,0 +1,87 @@
+package model
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/yashagw/event-management-api/pb"
+)
+
+var (
+	// ErrTicketAlreadyCancelled is returned when cancelling a ticket that has a refund
+	ErrTicketAlreadyCancelled = errors.New("ticket is already cancelled")
+	// ErrCancellationClosed is returned when cancelling a ticket after the cutoff of its event
+	ErrCancellationClosed = errors.New("tickets for this event can no longer be cancelled")
+)
+
+type RefundStatus int
+
+const (
+	RefundStatus_Pending RefundStatus = iota
+	RefundStatus_Refunded
+	RefundStatus_Failed
+)
+
+// Implement the Scan method for RefundStatus
+// It is used by the sql package to convert a value from the database into a RefundStatus
+func (es *RefundStatus) Scan(value interface{}) error {
+	if value == nil {
+		*es = 0
+		return nil
+	}
+
+	intValue, ok := value.(int64)
+	if !ok {
+		return fmt.Errorf("cannot scan value into RefundStatus")
+	}
+
+	*es = RefundStatus(intValue)
+	return nil
+}
+
+// Implement the Value method for RefundStatus
+// It is used by the sql package to convert a RefundStatus into a value that can be stored in the database
+func (es RefundStatus) Value() (driver.Value, error) {
+	return int64(es), nil
+}
+
+// Convert model.RefundStatus to pb.RefundStatus
+func (status RefundStatus) ToProto() pb.RefundStatus {
+	switch status {
+	case RefundStatus_Pending:
+		return pb.RefundStatus_RefundStatus_Pending
+	case RefundStatus_Refunded:
+		return pb.RefundStatus_RefundStatus_Refunded
+	case RefundStatus_Failed:
+		return pb.RefundStatus_RefundStatus_Failed
+	default:
+		return pb.RefundStatus_RefundStatus_Pending
+	}
+}
+
+// Refund represents a cancelled ticket in the database, the ticket row is kept
+// and its quantity is returned to the event
+type Refund struct {
+	ID        int64        `json:"id"`
+	TicketID  int64        `json:"ticket_id"`
+	UserID    int64        `json:"user_id"`
+	EventID   int64        `json:"event_id"`
+	Quantity  int64        `json:"quantity"`
+	Status    RefundStatus `json:"status"`
+	CreatedAt time.Time    `json:"created_at"`
+	UpdatedAt time.Time    `json:"updated_at"`
+}
+
+type CancelTicketParams struct {
+	UserID   int64 `json:"user_id"`
+	TicketID int64 `json:"ticket_id"`
+}
+
+// CancelTicketTxParams represents parameters to cancel a ticket together with its outbox messages
+type CancelTicketTxParams struct {
+	CancelTicketParams
+	// AfterCancel returns the messages to write to the outbox in the same transaction
+	AfterCancel func(refund *Refund) ([]CreateOutboxMessageParams, error)
+}
@@ -0,0 +1,52 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Session represents a login session holding a refresh token in the database
+type Session struct {
+	ID            uuid.UUID     `json:"id"`
+	UserID        int64         `json:"user_id"`
+	RefreshToken  string        `json:"refresh_token"`
+	AccessTokenID uuid.NullUUID `json:"access_token_id"`
+	UserAgent     string        `json:"user_agent"`
+	ClientIP      string        `json:"client_ip"`
+	IsBlocked     bool          `json:"is_blocked"`
+	ExpiresAt     time.Time     `json:"expires_at"`
+	CreatedAt     time.Time     `json:"created_at"`
+}
+
+// CreateSessionParams represents parameters to create a session
+type CreateSessionParams struct {
+	ID            uuid.UUID `json:"id"`
+	UserID        int64     `json:"user_id"`
+	RefreshToken  string    `json:"refresh_token"`
+	AccessTokenID uuid.UUID `json:"access_token_id"`
+	UserAgent     string    `json:"user_agent"`
+	ClientIP      string    `json:"client_ip"`
+	IsBlocked     bool      `json:"is_blocked"`
+	ExpiresAt     time.Time `json:"expires_at"`
+}
+
+// UpdateSessionAccessTokenParams represents parameters to record the latest
+// access token issued for a session
+type UpdateSessionAccessTokenParams struct {
+	ID            uuid.UUID `json:"id"`
+	AccessTokenID uuid.UUID `json:"access_token_id"`
+}
+
+// BlockSessionParams represents parameters to block a session of a user
+type BlockSessionParams struct {
+	ID     uuid.UUID `json:"id"`
+	UserID int64     `json:"user_id"`
+}
+
+// RevokeTokenParams represents parameters to revoke a token before it expires
+type RevokeTokenParams struct {
+	ID        uuid.UUID `json:"id"`
+	UserID    int64     `json:"user_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
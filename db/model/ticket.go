@@ -1,6 +1,14 @@
 package model
 
-import "time"
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrNotEnoughTickets is returned when buying more tickets than the event has left
+	ErrNotEnoughTickets = errors.New("not enough tickets left for the event")
+)
 
 // Ticket represents a ticket in the database
 type Ticket struct {
@@ -22,8 +30,92 @@ type CreateTicketParams struct {
 	Quantity int64 `json:"quantity"`
 }
 
+// CreateTicketTxParams represents parameters to buy a ticket together with its outbox messages
+type CreateTicketTxParams struct {
+	CreateTicketParams
+	// AfterCreate returns the messages to write to the outbox in the same transaction
+	AfterCreate func(ticket *Ticket) ([]CreateOutboxMessageParams, error)
+}
+
 type DeleteTicketParams struct {
 	UserID   int64 `json:"user_id"`
 	TicketID int64 `json:"ticket_id"`
 	EventID  int64 `json:"event_id"`
 }
+
+// TicketSortBy is the order in which tickets are listed
+type TicketSortBy string
+
+const (
+	// TicketSortBy_PurchaseDate lists the latest purchases first
+	TicketSortBy_PurchaseDate TicketSortBy = "purchase_date"
+	// TicketSortBy_EventStart lists the tickets of the soonest events first
+	TicketSortBy_EventStart TicketSortBy = "event_start"
+)
+
+// TicketPeriod narrows tickets down by when their event happens
+type TicketPeriod string
+
+const (
+	// TicketPeriod_Upcoming keeps tickets of events that have not ended yet
+	TicketPeriod_Upcoming TicketPeriod = "upcoming"
+	// TicketPeriod_Past keeps tickets of events that have ended
+	TicketPeriod_Past TicketPeriod = "past"
+)
+
+type ListTicketsParams struct {
+	UserID int64 `json:"user_id"`
+	// Search matches the name or the location of the event, case insensitive
+	Search string       `json:"search"`
+	Period TicketPeriod `json:"period"`
+	SortBy TicketSortBy `json:"sort_by"`
+	Limit  int          `json:"limit"`
+	Offset int          `json:"offset"`
+}
+
+// UserTicket is a ticket together with the event it was bought for
+type UserTicket struct {
+	Ticket
+	Event     Event `json:"event"`
+	Cancelled bool  `json:"cancelled"`
+}
+
+type ListTicketsResponse struct {
+	Records    []UserTicket `json:"records"`
+	NextOffset int          `json:"next_offset"`
+}
+
+type ListEventTicketsParams struct {
+	EventID int64 `json:"event_id"`
+	Limit   int   `json:"limit"`
+	Offset  int   `json:"offset"`
+}
+
+// EventTicket is a ticket sold for an event together with its buyer
+type EventTicket struct {
+	Ticket
+	BuyerName  string `json:"buyer_name"`
+	BuyerEmail string `json:"buyer_email"`
+	Cancelled  bool   `json:"cancelled"`
+}
+
+type ListEventTicketsResponse struct {
+	Records    []EventTicket `json:"records"`
+	NextOffset int           `json:"next_offset"`
+}
+
+// DailySales is the number of tickets bought on a day
+type DailySales struct {
+	Day     time.Time `json:"day"`
+	Tickets int64     `json:"tickets"`
+}
+
+// EventSalesStats sums up the ticket sales of an event, cancelled tickets are
+// only counted in Cancelled
+type EventSalesStats struct {
+	Sold      int64 `json:"sold"`
+	Remaining int64 `json:"remaining"`
+	// Cancelled is the number of tickets that were cancelled
+	Cancelled int64        `json:"cancelled"`
+	Daily     []DailySales `json:"daily"`
+}
@@ -63,6 +63,7 @@ type User struct {
 	Email             string    `json:"email"`
 	HashedPassword    string    `json:"hashed_password"`
 	Role              UserRole  `json:"role"`
+	IsEmailVerified   bool      `json:"is_email_verified"`
 	CreatedAt         time.Time `json:"created_at"`
 	PasswordUpdatedAt time.Time `json:"password_updated_at"`
 }
@@ -73,3 +74,13 @@ type CreateUserParams struct {
 	Email          string `json:"email"`
 	HashedPassword string `json:"hashed_password"`
 }
+
+// CreateUserTxParams represents parameters to create a user together with its outbox messages
+type CreateUserTxParams struct {
+	CreateUserParams
+	// SecretCode, when set, is stored as the first code to verify the email of the user
+	SecretCode string `json:"secret_code"`
+	// AfterCreate returns the messages to write to the outbox in the same transaction,
+	// verifyEmail is nil when no SecretCode is given
+	AfterCreate func(user *User, verifyEmail *VerifyEmail) ([]CreateOutboxMessageParams, error)
+}
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrEmailAlreadyVerified is returned when sending a code to an email that is already verified
+	ErrEmailAlreadyVerified = errors.New("email is already verified")
+	// ErrVerifyEmailTooSoon is returned when a new code is asked for too soon after the previous one
+	ErrVerifyEmailTooSoon = errors.New("a verification email was sent recently, try again later")
+)
+
+// VerifyEmail represents a code sent to a user to verify their email address
+type VerifyEmail struct {
+	ID         int64     `json:"id"`
+	UserID     int64     `json:"user_id"`
+	Email      string    `json:"email"`
+	SecretCode string    `json:"secret_code"`
+	IsUsed     bool      `json:"is_used"`
+	CreatedAt  time.Time `json:"created_at"`
+	ExpiredAt  time.Time `json:"expired_at"`
+}
+
+// CreateVerifyEmailParams represents parameters to create a verify email code
+type CreateVerifyEmailParams struct {
+	UserID     int64  `json:"user_id"`
+	Email      string `json:"email"`
+	SecretCode string `json:"secret_code"`
+}
+
+// ResendVerifyEmailTxParams represents parameters to create a new verify email
+// code together with the outbox message that sends it
+type ResendVerifyEmailTxParams struct {
+	UserID     int64  `json:"user_id"`
+	SecretCode string `json:"secret_code"`
+	// Interval is the least time between two codes of the user
+	Interval time.Duration
+	// AfterCreate returns the messages to write to the outbox in the same transaction
+	AfterCreate func(verifyEmail *VerifyEmail) ([]CreateOutboxMessageParams, error)
+}
+
+// VerifyEmailParams represents parameters to verify an email address
+type VerifyEmailParams struct {
+	EmailID    int64  `json:"email_id"`
+	SecretCode string `json:"secret_code"`
+}
@@ -2,19 +2,26 @@ package pgsql
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
+	"time"
+	"unicode"
 
 	"github.com/yashagw/event-management-api/db/model"
 )
 
 func (provider *Provider) CreateEvent(context context.Context, request model.CreateEventParams) (*model.Event, error) {
+	if !request.EndDate.After(request.StartDate) {
+		return nil, model.ErrInvalidEventDates
+	}
+
 	var event model.Event
 	err := provider.conn.QueryRowContext(context, `
-		INSERT INTO events (host_id, name, description, location, total_tickets, left_tickets, start_date, end_date)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-		RETURNING id, host_id, name, description, location, total_tickets, left_tickets, start_date, end_date, created_at
-	`, request.HostID, request.Name, request.Description, request.Location, request.TotalTickets, request.TotalTickets, request.StartDate, request.EndDate).Scan(
+		INSERT INTO events (host_id, name, description, location, total_tickets, left_tickets, start_date, end_date, cancellation_cutoff_hours)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, host_id, name, description, location, total_tickets, left_tickets, start_date, end_date, created_at, cancellation_cutoff_hours, status
+	`, request.HostID, request.Name, request.Description, request.Location, request.TotalTickets, request.TotalTickets, request.StartDate, request.EndDate, request.CancellationCutoffHours).Scan(
 		&event.ID,
 		&event.HostID,
 		&event.Name,
@@ -25,10 +32,13 @@ func (provider *Provider) CreateEvent(context context.Context, request model.Cre
 		&event.StartDate,
 		&event.EndDate,
 		&event.CreatedAt,
+		&event.CancellationCutoffHours,
+		&event.Status,
 	)
 	if err != nil {
 		return nil, err
 	}
+	event.Status = event.CurrentStatus(time.Now())
 
 	return &event, nil
 }
@@ -36,10 +46,10 @@ func (provider *Provider) CreateEvent(context context.Context, request model.Cre
 func (provider *Provider) GetEvent(context context.Context, request model.GetEventParams) (*model.Event, error) {
 	var event model.Event
 	err := provider.conn.QueryRowContext(context, `
-		SELECT id, host_id, name, description, location, total_tickets, left_tickets, start_date, end_date, created_at
+		SELECT id, host_id, name, description, location, total_tickets, left_tickets, start_date, end_date, created_at, cancellation_cutoff_hours, status
 		FROM events
-		WHERE id = $1
-	`, request.EventID).Scan(
+		WHERE id = $1 AND (NOT $2 OR status != $3)
+	`, request.EventID, request.Published, model.EventStatus_Draft).Scan(
 		&event.ID,
 		&event.HostID,
 		&event.Name,
@@ -50,10 +60,13 @@ func (provider *Provider) GetEvent(context context.Context, request model.GetEve
 		&event.StartDate,
 		&event.EndDate,
 		&event.CreatedAt,
+		&event.CancellationCutoffHours,
+		&event.Status,
 	)
 	if err != nil {
 		return nil, err
 	}
+	event.Status = event.CurrentStatus(time.Now())
 
 	return &event, nil
 }
@@ -63,7 +76,7 @@ func (provider *Provider) ListEvents(context context.Context, request model.List
 		request.Limit = 100
 	}
 
-	baseQuery := "SELECT id, host_id, name, description, location, total_tickets, left_tickets, start_date, end_date, created_at FROM events"
+	baseQuery := "SELECT id, host_id, name, description, location, total_tickets, left_tickets, start_date, end_date, created_at, cancellation_cutoff_hours, status FROM events"
 
 	var filters []string
 	args := make([]interface{}, 0)
@@ -72,6 +85,11 @@ func (provider *Provider) ListEvents(context context.Context, request model.List
 		filters = append(filters, "host_id = $"+fmt.Sprint(len(args)+3))
 		args = append(args, request.HostID)
 	}
+	if request.Published {
+		filters = append(filters, "status != $"+fmt.Sprint(len(args)+3))
+		args = append(args, model.EventStatus_Draft)
+	}
+	filters, args = appendEventFilters(filters, args, request.EventFilters, 3)
 
 	var whereClause string
 	if len(filters) > 0 {
@@ -79,7 +97,7 @@ func (provider *Provider) ListEvents(context context.Context, request model.List
 	}
 
 	// Construct the final query
-	finalQuery := baseQuery + " " + whereClause + " LIMIT $1 OFFSET $2"
+	finalQuery := baseQuery + " " + whereClause + " ORDER BY start_date, id LIMIT $1 OFFSET $2"
 
 	// Combine query arguments
 	queryArgs := append([]interface{}{request.Limit, request.Offset}, args...)
@@ -93,6 +111,7 @@ func (provider *Provider) ListEvents(context context.Context, request model.List
 
 	var events []model.Event
 	nextOffset := request.Offset
+	now := time.Now()
 
 	for rows.Next() {
 		var event model.Event
@@ -107,10 +126,13 @@ func (provider *Provider) ListEvents(context context.Context, request model.List
 			&event.StartDate,
 			&event.EndDate,
 			&event.CreatedAt,
+			&event.CancellationCutoffHours,
+			&event.Status,
 		)
 		if err != nil {
 			return nil, err
 		}
+		event.Status = event.CurrentStatus(now)
 
 		events = append(events, event)
 		nextOffset++
@@ -122,6 +144,457 @@ func (provider *Provider) ListEvents(context context.Context, request model.List
 	}, nil
 }
 
+func (provider *Provider) SearchEvents(context context.Context, request model.SearchEventsParams) (*model.SearchEventsResponse, error) {
+	if request.Limit <= 0 {
+		request.Limit = 100
+	}
+
+	query := prefixQuery(request.Query)
+	if query == "" {
+		return &model.SearchEventsResponse{NextOffset: request.Offset}, nil
+	}
+
+	filters := []string{"search @@ query"}
+	args := []interface{}{query}
+
+	if request.HostID != 0 {
+		filters = append(filters, "host_id = $"+fmt.Sprint(len(args)+3))
+		args = append(args, request.HostID)
+	}
+	if request.Published {
+		filters = append(filters, "status != $"+fmt.Sprint(len(args)+3))
+		args = append(args, model.EventStatus_Draft)
+	}
+	filters, args = appendEventFilters(filters, args, request.EventFilters, 3)
+
+	// The headlines are only built for the page that is returned
+	finalQuery := `
+		SELECT id, host_id, name, description, location, total_tickets, left_tickets, start_date, end_date, created_at, cancellation_cutoff_hours, status, rank,
+			ts_headline('english', name || ' ' || location || ' ' || description, query, 'MaxWords=30, MinWords=10, MaxFragments=2')
+		FROM (
+			SELECT events.*, query, ts_rank(search, query) AS rank
+			FROM events, to_tsquery('english', $3) query
+			WHERE ` + strings.Join(filters, " AND ") + `
+			ORDER BY rank DESC, id
+			LIMIT $1 OFFSET $2
+		) matches
+		ORDER BY rank DESC, id
+	`
+	queryArgs := append([]interface{}{request.Limit, request.Offset}, args...)
+
+	rows, err := provider.conn.QueryContext(context, finalQuery, queryArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []model.EventSearchResult
+	nextOffset := request.Offset
+	now := time.Now()
+
+	for rows.Next() {
+		var result model.EventSearchResult
+		err := rows.Scan(
+			&result.ID,
+			&result.HostID,
+			&result.Name,
+			&result.Description,
+			&result.Location,
+			&result.TotalTickets,
+			&result.LeftTickets,
+			&result.StartDate,
+			&result.EndDate,
+			&result.CreatedAt,
+			&result.CancellationCutoffHours,
+			&result.Status,
+			&result.Rank,
+			&result.Headline,
+		)
+		if err != nil {
+			return nil, err
+		}
+		result.Status = result.CurrentStatus(now)
+
+		results = append(results, result)
+		nextOffset++
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &model.SearchEventsResponse{
+		Records:    results,
+		NextOffset: nextOffset,
+	}, nil
+}
+
+// prefixQuery turns the words of search into a tsquery that matches events
+// containing all of them, each word as the start of a word
+func prefixQuery(search string) string {
+	words := strings.FieldsFunc(search, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, word := range words {
+		words[i] = word + ":*"
+	}
+	return strings.Join(words, " & ")
+}
+
+// appendEventFilters adds the conditions of f to filters, the placeholders of
+// args are numbered from first
+func appendEventFilters(filters []string, args []interface{}, f model.EventFilters, first int) ([]string, []interface{}) {
+	placeholder := func(arg interface{}) string {
+		args = append(args, arg)
+		return "$" + fmt.Sprint(len(args)+first-1)
+	}
+
+	switch f.Period {
+	case model.EventPeriod_Ongoing:
+		filters = append(filters, "start_date <= now() AND end_date > now()")
+	case model.EventPeriod_Upcoming:
+		filters = append(filters, "start_date > now()")
+	case model.EventPeriod_Past:
+		filters = append(filters, "end_date <= now()")
+	}
+	if f.DurationDays > 0 {
+		// Same expression as the index on the duration
+		filters = append(filters, "(end_date::date - start_date::date) = "+placeholder(f.DurationDays-1))
+	}
+	if !f.Month.IsZero() {
+		start := time.Date(f.Month.Year(), f.Month.Month(), 1, 0, 0, 0, 0, time.UTC)
+		filters = append(filters, "start_date < "+placeholder(start.AddDate(0, 1, 0)))
+		filters = append(filters, "end_date >= "+placeholder(start))
+	}
+	if f.City != "" {
+		filters = append(filters, "location ILIKE "+placeholder("%"+escapeLike(f.City)+"%"))
+	}
+	if f.MinTicketsLeft > 0 {
+		filters = append(filters, "left_tickets >= "+placeholder(f.MinTicketsLeft))
+	}
+
+	return filters, args
+}
+
+func (provider *Provider) UpdateEvent(ctx context.Context, request model.UpdateEventParams) (*model.Event, error) {
+	return provider.UpdateEventTx(ctx, model.UpdateEventTxParams{UpdateEventParams: request})
+}
+
+// UpdateEventTx applies the changes of request to an event of request.HostID
+// and writes the outbox messages returned by request.AfterUpdate for its
+// ticket holders in one transaction
+func (provider *Provider) UpdateEventTx(ctx context.Context, request model.UpdateEventTxParams) (*model.Event, error) {
+	txProvider, err := provider.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			txProvider.tx.Rollback()
+		}
+		txProvider.Close()
+	}()
+
+	// Lock the event so that no tickets are sold while it changes
+	var event model.Event
+	err = txProvider.tx.QueryRowContext(ctx, `
+		SELECT id, host_id, name, description, location, total_tickets, left_tickets, start_date, end_date, created_at, cancellation_cutoff_hours, status
+		FROM events
+		WHERE id = $1
+		FOR UPDATE
+	`, request.EventID).Scan(
+		&event.ID,
+		&event.HostID,
+		&event.Name,
+		&event.Description,
+		&event.Location,
+		&event.TotalTickets,
+		&event.LeftTickets,
+		&event.StartDate,
+		&event.EndDate,
+		&event.CreatedAt,
+		&event.CancellationCutoffHours,
+		&event.Status,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if event.HostID != request.HostID {
+		err = model.ErrEventNotOwned
+		return nil, err
+	}
+	event.Status = event.CurrentStatus(time.Now())
+	switch event.Status {
+	case model.EventStatus_Cancelled:
+		err = model.ErrEventCancelled
+		return nil, err
+	case model.EventStatus_Completed:
+		err = model.ErrEventEnded
+		return nil, err
+	}
+
+	userIDs, err := ticketHolders(ctx, txProvider.tx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := event
+	if request.Description != nil {
+		updated.Description = *request.Description
+	}
+	if request.Name != nil {
+		updated.Name = *request.Name
+	}
+	if request.Location != nil {
+		updated.Location = *request.Location
+	}
+	if request.StartDate != nil {
+		updated.StartDate = *request.StartDate
+	}
+	if request.EndDate != nil {
+		updated.EndDate = *request.EndDate
+	}
+	if request.TotalTickets != nil {
+		// Tickets that are sold stay sold
+		updated.LeftTickets += *request.TotalTickets - event.TotalTickets
+		updated.TotalTickets = *request.TotalTickets
+	}
+
+	descriptionOnly := updated.Name == event.Name &&
+		updated.Location == event.Location &&
+		updated.TotalTickets == event.TotalTickets &&
+		updated.StartDate.Equal(event.StartDate) &&
+		updated.EndDate.Equal(event.EndDate)
+	if len(userIDs) > 0 && !descriptionOnly {
+		err = model.ErrEventTicketsSold
+		return nil, err
+	}
+	if !updated.EndDate.After(updated.StartDate) {
+		err = model.ErrInvalidEventDates
+		return nil, err
+	}
+	if descriptionOnly && updated.Description == event.Description {
+		err = txProvider.tx.Commit()
+		if err != nil {
+			return nil, err
+		}
+		return &event, nil
+	}
+
+	_, err = txProvider.tx.ExecContext(ctx, `
+		UPDATE events
+		SET name = $2, description = $3, location = $4, total_tickets = $5, left_tickets = $6, start_date = $7, end_date = $8
+		WHERE id = $1
+	`, updated.ID, updated.Name, updated.Description, updated.Location, updated.TotalTickets, updated.LeftTickets, updated.StartDate, updated.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.AfterUpdate != nil && len(userIDs) > 0 {
+		var messages []model.CreateOutboxMessageParams
+		messages, err = request.AfterUpdate(&updated, userIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		err = createOutboxMessages(ctx, txProvider.tx, messages)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err = txProvider.tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// UpdateEventStatus publishes or unpublishes an event of request.HostID. An
+// event with tickets sold cannot be unpublished.
+func (provider *Provider) UpdateEventStatus(ctx context.Context, request model.UpdateEventStatusParams) (*model.Event, error) {
+	txProvider, err := provider.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			txProvider.tx.Rollback()
+		}
+		txProvider.Close()
+	}()
+
+	// Lock the event so that no tickets are sold while it is unpublished
+	var event model.Event
+	err = txProvider.tx.QueryRowContext(ctx, `
+		SELECT id, host_id, name, description, location, total_tickets, left_tickets, start_date, end_date, created_at, cancellation_cutoff_hours, status
+		FROM events
+		WHERE id = $1
+		FOR UPDATE
+	`, request.EventID).Scan(
+		&event.ID,
+		&event.HostID,
+		&event.Name,
+		&event.Description,
+		&event.Location,
+		&event.TotalTickets,
+		&event.LeftTickets,
+		&event.StartDate,
+		&event.EndDate,
+		&event.CreatedAt,
+		&event.CancellationCutoffHours,
+		&event.Status,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if event.HostID != request.HostID {
+		err = model.ErrEventNotOwned
+		return nil, err
+	}
+	// Cancelling refunds tickets, it goes through CancelEventTx
+	if request.Status == model.EventStatus_Cancelled {
+		err = model.ErrInvalidStatusTransition
+		return nil, err
+	}
+	now := time.Now()
+	err = event.ValidateTransition(request.Status, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Status == model.EventStatus_Draft {
+		var userIDs []int64
+		userIDs, err = ticketHolders(ctx, txProvider.tx, event.ID)
+		if err != nil {
+			return nil, err
+		}
+		if len(userIDs) > 0 {
+			err = model.ErrEventTicketsSold
+			return nil, err
+		}
+	}
+
+	_, err = txProvider.tx.ExecContext(ctx, `
+		UPDATE events SET status = $2 WHERE id = $1
+	`, event.ID, request.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = txProvider.tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	event.Status = request.Status
+	event.Status = event.CurrentStatus(now)
+	return &event, nil
+}
+
+func (provider *Provider) CancelEvent(ctx context.Context, request model.CancelEventParams) (*model.Event, error) {
+	return provider.CancelEventTx(ctx, model.CancelEventTxParams{CancelEventParams: request})
+}
+
+// CancelEventTx marks an event of request.HostID as cancelled, refunds every
+// ticket that was not cancelled yet and writes the outbox messages returned by
+// request.AfterCancel for the ticket holders in one transaction. The event
+// stays readable.
+func (provider *Provider) CancelEventTx(ctx context.Context, request model.CancelEventTxParams) (*model.Event, error) {
+	txProvider, err := provider.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			txProvider.tx.Rollback()
+		}
+		txProvider.Close()
+	}()
+
+	// Lock the event so that no tickets are sold or cancelled meanwhile
+	var event model.Event
+	err = txProvider.tx.QueryRowContext(ctx, `
+		SELECT id, host_id, name, description, location, total_tickets, left_tickets, start_date, end_date, created_at, cancellation_cutoff_hours, status
+		FROM events
+		WHERE id = $1
+		FOR UPDATE
+	`, request.EventID).Scan(
+		&event.ID,
+		&event.HostID,
+		&event.Name,
+		&event.Description,
+		&event.Location,
+		&event.TotalTickets,
+		&event.LeftTickets,
+		&event.StartDate,
+		&event.EndDate,
+		&event.CreatedAt,
+		&event.CancellationCutoffHours,
+		&event.Status,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if event.HostID != request.HostID {
+		err = model.ErrEventNotOwned
+		return nil, err
+	}
+	err = event.ValidateTransition(model.EventStatus_Cancelled, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs, err := ticketHolders(ctx, txProvider.tx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Refund the tickets that were not cancelled by their buyers
+	_, err = txProvider.tx.ExecContext(ctx, `
+		INSERT INTO refunds (ticket_id, user_id, event_id, quantity, status)
+		SELECT id, user_id, event_id, quantity, $2
+		FROM tickets
+		WHERE event_id = $1 AND NOT EXISTS (SELECT 1 FROM refunds WHERE refunds.ticket_id = tickets.id)
+	`, event.ID, model.RefundStatus_Pending)
+	if err != nil {
+		return nil, err
+	}
+
+	event.Status = model.EventStatus_Cancelled
+	event.LeftTickets = event.TotalTickets
+	_, err = txProvider.tx.ExecContext(ctx, `
+		UPDATE events SET status = $2, left_tickets = total_tickets, cancelled_at = now()
+		WHERE id = $1
+	`, event.ID, event.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.AfterCancel != nil && len(userIDs) > 0 {
+		var messages []model.CreateOutboxMessageParams
+		messages, err = request.AfterCancel(&event, userIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		err = createOutboxMessages(ctx, txProvider.tx, messages)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err = txProvider.tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
 func (provider *Provider) DeleteEvent(context context.Context, id int64) error {
 	_, err := provider.conn.ExecContext(context, `
 		DELETE FROM events
@@ -133,3 +606,134 @@ func (provider *Provider) DeleteEvent(context context.Context, id int64) error {
 
 	return nil
 }
+
+// CompleteEvents marks events that ended before endedBefore as completed,
+// published events get the completed status while drafts and cancelled
+// events keep theirs
+func (provider *Provider) CompleteEvents(context context.Context, endedBefore time.Time) (int64, error) {
+	result, err := provider.conn.ExecContext(context, `
+		UPDATE events SET completed_at = now(),
+			status = CASE WHEN status = $2 THEN $3 ELSE status END
+		WHERE completed_at IS NULL AND end_date < $1
+	`, endedBefore, model.EventStatus_Published, model.EventStatus_Completed)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// QueueEventReminders finds events starting between arg.Now and arg.StartsBefore
+// that were not reminded yet, and writes the messages built by arg.BuildMessages
+// for their ticket holders to the outbox in the same transaction that marks them
+// as reminded. Events locked by a concurrent run are skipped.
+func (provider *Provider) QueueEventReminders(ctx context.Context, arg model.QueueEventRemindersParams) (int64, error) {
+	txProvider, err := provider.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() {
+		if err != nil {
+			txProvider.tx.Rollback()
+		}
+		txProvider.Close()
+	}()
+
+	rows, err := txProvider.tx.QueryContext(ctx, `
+		SELECT id, host_id, name, description, location, total_tickets, left_tickets, start_date, end_date, created_at, cancellation_cutoff_hours, status
+		FROM events
+		WHERE reminder_sent_at IS NULL AND start_date > $1 AND start_date <= $2 AND status = $3
+		ORDER BY start_date
+		FOR UPDATE SKIP LOCKED
+	`, arg.Now, arg.StartsBefore, model.EventStatus_Published)
+	if err != nil {
+		return 0, err
+	}
+
+	var events []*model.Event
+	for rows.Next() {
+		var event model.Event
+		err = rows.Scan(
+			&event.ID,
+			&event.HostID,
+			&event.Name,
+			&event.Description,
+			&event.Location,
+			&event.TotalTickets,
+			&event.LeftTickets,
+			&event.StartDate,
+			&event.EndDate,
+			&event.CreatedAt,
+			&event.CancellationCutoffHours,
+			&event.Status,
+		)
+		if err != nil {
+			rows.Close()
+			return 0, err
+		}
+		events = append(events, &event)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+
+	for _, event := range events {
+		var userIDs []int64
+		userIDs, err = ticketHolders(ctx, txProvider.tx, event.ID)
+		if err != nil {
+			return 0, err
+		}
+
+		if len(userIDs) > 0 {
+			var messages []model.CreateOutboxMessageParams
+			messages, err = arg.BuildMessages(event, userIDs)
+			if err != nil {
+				return 0, err
+			}
+
+			err = createOutboxMessages(ctx, txProvider.tx, messages)
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		_, err = txProvider.tx.ExecContext(ctx, `
+			UPDATE events SET reminder_sent_at = now() WHERE id = $1
+		`, event.ID)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if err = txProvider.tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return int64(len(events)), nil
+}
+
+// ticketHolders returns the users holding tickets for an event that were not cancelled
+func ticketHolders(ctx context.Context, tx *sql.Tx, eventID int64) ([]int64, error) {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT DISTINCT user_id FROM tickets
+		WHERE event_id = $1 AND NOT EXISTS (SELECT 1 FROM refunds WHERE refunds.ticket_id = tickets.id)
+		ORDER BY user_id
+	`, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var userIDs []int64
+	for rows.Next() {
+		var userID int64
+		if err := rows.Scan(&userID); err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	return userIDs, rows.Err()
+}
@@ -2,6 +2,7 @@ package pgsql
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 	"time"
 
@@ -34,8 +35,22 @@ func CreateRandomEvent(t *testing.T, host *model.User) *model.Event {
 	require.Equal(t, arg.StartDate.Format(time.RFC3339), event.StartDate.Format(time.RFC3339)) // Compare formatted time strings
 	require.Equal(t, arg.EndDate.Format(time.RFC3339), event.EndDate.Format(time.RFC3339))     // Compare formatted time strings
 	require.NotEmpty(t, event.CreatedAt)
+	require.Equal(t, model.EventStatus_Draft, event.Status)
 
-	return event
+	return publishEvent(t, event)
+}
+
+// publishEvent puts an event on sale
+func publishEvent(t *testing.T, event *model.Event) *model.Event {
+	published, err := provider.UpdateEventStatus(context.Background(), model.UpdateEventStatusParams{
+		EventID: event.ID,
+		HostID:  event.HostID,
+		Status:  model.EventStatus_Published,
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, model.EventStatus_Draft, published.Status)
+
+	return published
 }
 
 func TestCreateEvent(t *testing.T) {
@@ -56,6 +71,27 @@ func TestCreateEvent(t *testing.T) {
 	require.Equal(t, event, fetchedEvent)
 }
 
+func TestCreateEventInvalidDates(t *testing.T) {
+	host := CreateRandomUser(t)
+	defer func() {
+		err := provider.DeleteUser(context.Background(), host.ID)
+		require.NoError(t, err)
+	}()
+
+	startDate := time.Now().Add(24 * time.Hour).UTC()
+	event, err := provider.CreateEvent(context.Background(), model.CreateEventParams{
+		HostID:       host.ID,
+		Name:         util.RandomName(),
+		Description:  util.RandomString(10),
+		Location:     util.RandomString(10),
+		TotalTickets: util.RandomInt(1, 100),
+		StartDate:    startDate,
+		EndDate:      startDate.Add(-time.Hour),
+	})
+	require.ErrorIs(t, err, model.ErrInvalidEventDates)
+	require.Nil(t, event)
+}
+
 func TestDeleteEvent(t *testing.T) {
 	host := CreateRandomUser(t)
 	event := CreateRandomEvent(t, host)
@@ -118,8 +154,583 @@ func TestListEvents(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 10, len(response.Records))
 
+	// Pages are stable, the events come by start date then id
+	for i := 1; i < len(response.Records); i++ {
+		previous, current := response.Records[i-1], response.Records[i]
+		require.False(t, current.StartDate.Before(previous.StartDate))
+		if current.StartDate.Equal(previous.StartDate) {
+			require.Greater(t, current.ID, previous.ID)
+		}
+	}
+
 	// List all events
 	response, err = provider.ListEvents(context.Background(), model.ListEventsParams{})
 	require.NoError(t, err)
 	require.Equal(t, 20, len(response.Records))
 }
+
+func TestListEventsFilters(t *testing.T) {
+	host := CreateRandomUser(t)
+	defer func() {
+		err := provider.DeleteUser(context.Background(), host.ID)
+		require.NoError(t, err)
+	}()
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	create := func(location string, tickets int64, start, end time.Time) *model.Event {
+		event, err := provider.CreateEvent(context.Background(), model.CreateEventParams{
+			HostID:       host.ID,
+			Name:         util.RandomName(),
+			Description:  util.RandomString(10),
+			Location:     location,
+			TotalTickets: tickets,
+			StartDate:    start,
+			EndDate:      end,
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := provider.DeleteEvent(context.Background(), event.ID)
+			require.NoError(t, err)
+		})
+		return event
+	}
+
+	// Drafts are listed to the host, so the past event does not need publishing
+	past := create("Kreuzberg, Berlin", 10, today.AddDate(0, 0, -10).Add(10*time.Hour), today.AddDate(0, 0, -10).Add(18*time.Hour))
+	ongoing := create("Hamburg", 5, time.Now().UTC().Add(-time.Hour), time.Now().UTC().Add(time.Hour))
+	upcoming := create("Munich", 50, today.AddDate(0, 0, 40).Add(10*time.Hour), today.AddDate(0, 0, 41).Add(12*time.Hour))
+	upcomingMonth := upcoming.StartDate
+
+	testCases := []struct {
+		name    string
+		filters model.EventFilters
+		want    []*model.Event
+	}{
+		{name: "None", want: []*model.Event{past, ongoing, upcoming}},
+		{name: "Ongoing", filters: model.EventFilters{Period: model.EventPeriod_Ongoing}, want: []*model.Event{ongoing}},
+		{name: "Upcoming", filters: model.EventFilters{Period: model.EventPeriod_Upcoming}, want: []*model.Event{upcoming}},
+		{name: "Past", filters: model.EventFilters{Period: model.EventPeriod_Past}, want: []*model.Event{past}},
+		{name: "Duration", filters: model.EventFilters{DurationDays: 2}, want: []*model.Event{upcoming}},
+		{name: "Month", filters: model.EventFilters{Month: upcomingMonth}, want: []*model.Event{upcoming}},
+		{name: "City", filters: model.EventFilters{City: "berlin"}, want: []*model.Event{past}},
+		{name: "Month And City", filters: model.EventFilters{Month: upcomingMonth, City: "munich"}, want: []*model.Event{upcoming}},
+		{name: "Month And Other City", filters: model.EventFilters{Month: upcomingMonth, City: "berlin"}},
+		{name: "Min Tickets Left", filters: model.EventFilters{MinTicketsLeft: 10}, want: []*model.Event{past, upcoming}},
+		{name: "Wildcard City", filters: model.EventFilters{City: "%"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			response, err := provider.ListEvents(context.Background(), model.ListEventsParams{
+				HostID:       host.ID,
+				EventFilters: tc.filters,
+				Limit:        10,
+			})
+			require.NoError(t, err)
+
+			var want, got []int64
+			for _, event := range tc.want {
+				want = append(want, event.ID)
+			}
+			for _, event := range response.Records {
+				got = append(got, event.ID)
+			}
+			require.ElementsMatch(t, want, got)
+		})
+	}
+}
+
+func TestSearchEvents(t *testing.T) {
+	host := CreateRandomUser(t)
+	defer func() {
+		err := provider.DeleteUser(context.Background(), host.ID)
+		require.NoError(t, err)
+	}()
+
+	// A random word keeps the search away from the events of other tests
+	tag := util.RandomString(12)
+	create := func(name, location, description string) *model.Event {
+		event, err := provider.CreateEvent(context.Background(), model.CreateEventParams{
+			HostID:       host.ID,
+			Name:         name,
+			Description:  description,
+			Location:     location,
+			TotalTickets: 10,
+			StartDate:    time.Now().UTC().Add(24 * time.Hour),
+			EndDate:      time.Now().UTC().Add(48 * time.Hour),
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := provider.DeleteEvent(context.Background(), event.ID)
+			require.NoError(t, err)
+		})
+		return event
+	}
+
+	inName := publishEvent(t, create("Jazz "+tag, "Berlin", "An evening of music"))
+	inDescription := publishEvent(t, create("Open Air", "Munich", "Jazz and blues all night long "+tag))
+	draft := create("Jazz Brunch "+tag, "Berlin", "Draft event")
+
+	search := func(params model.SearchEventsParams) []model.EventSearchResult {
+		params.Limit = 10
+		response, err := provider.SearchEvents(context.Background(), params)
+		require.NoError(t, err)
+		return response.Records
+	}
+
+	// Matches in the name rank first, drafts are hidden from guests
+	results := search(model.SearchEventsParams{Query: "jazz " + tag, Published: true})
+	require.Len(t, results, 2)
+	require.Equal(t, inName.ID, results[0].ID)
+	require.Equal(t, inDescription.ID, results[1].ID)
+	require.Greater(t, results[0].Rank, results[1].Rank)
+	require.Contains(t, results[0].Headline, "<b>Jazz</b>")
+	require.Equal(t, model.EventStatus_Published, results[0].Status)
+
+	// The last word can be the start of a word
+	results = search(model.SearchEventsParams{Query: "blu " + tag[:6], Published: true})
+	require.Len(t, results, 1)
+	require.Equal(t, inDescription.ID, results[0].ID)
+
+	// The host sees drafts, and searches combine with the list filters
+	results = search(model.SearchEventsParams{Query: "jazz " + tag, HostID: host.ID})
+	require.Len(t, results, 3)
+
+	results = search(model.SearchEventsParams{
+		Query:        "jazz " + tag,
+		HostID:       host.ID,
+		EventFilters: model.EventFilters{City: "berlin"},
+	})
+	var ids []int64
+	for _, result := range results {
+		ids = append(ids, result.ID)
+	}
+	require.ElementsMatch(t, []int64{inName.ID, draft.ID}, ids)
+
+	// Words without letters or digits match nothing instead of failing
+	results = search(model.SearchEventsParams{Query: "&|!:*()"})
+	require.Empty(t, results)
+}
+
+func TestPrefixQuery(t *testing.T) {
+	require.Equal(t, "Rock:* & nig:*", prefixQuery("Rock nig"))
+	require.Equal(t, "rock:* & n:*", prefixQuery("rock & n:*"))
+	require.Equal(t, "café:*", prefixQuery("  café! "))
+	require.Empty(t, prefixQuery("'|!()"))
+}
+
+func TestCompleteEvents(t *testing.T) {
+	host := CreateRandomUser(t)
+	event := CreateRandomEvent(t, host)
+	defer func() {
+		err := provider.DeleteEvent(context.Background(), event.ID)
+		require.NoError(t, err)
+
+		err = provider.DeleteUser(context.Background(), host.ID)
+		require.NoError(t, err)
+	}()
+
+	// The event has not ended yet
+	_, err := provider.CompleteEvents(context.Background(), event.EndDate.Add(-time.Minute))
+	require.NoError(t, err)
+
+	var completed bool
+	err = provider.conn.QueryRow(`SELECT completed_at IS NOT NULL FROM events WHERE id = $1`, event.ID).Scan(&completed)
+	require.NoError(t, err)
+	require.False(t, completed)
+
+	count, err := provider.CompleteEvents(context.Background(), event.EndDate.Add(time.Minute))
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, count, int64(1))
+
+	err = provider.conn.QueryRow(`SELECT completed_at IS NOT NULL FROM events WHERE id = $1`, event.ID).Scan(&completed)
+	require.NoError(t, err)
+	require.True(t, completed)
+
+	// The stored status wins over the dates, so tickets are no longer sold
+	fetched, err := provider.GetEvent(context.Background(), model.GetEventParams{EventID: event.ID})
+	require.NoError(t, err)
+	require.Equal(t, model.EventStatus_Completed, fetched.Status)
+
+	_, err = provider.CreateTicketTx(context.Background(), model.CreateTicketTxParams{
+		CreateTicketParams: model.CreateTicketParams{
+			UserID:   host.ID,
+			EventID:  event.ID,
+			Quantity: 1,
+		},
+	})
+	require.ErrorIs(t, err, model.ErrEventEnded)
+}
+
+func TestQueueEventReminders(t *testing.T) {
+	host := CreateRandomUser(t)
+	user := CreateRandomUser(t)
+	event, err := provider.CreateEvent(context.Background(), model.CreateEventParams{
+		HostID:       host.ID,
+		Name:         util.RandomName(),
+		Description:  util.RandomString(10),
+		Location:     util.RandomString(10),
+		TotalTickets: 10,
+		StartDate:    time.Now().Add(2 * time.Hour).UTC(),
+		EndDate:      time.Now().Add(4 * time.Hour).UTC(),
+	})
+	require.NoError(t, err)
+	event = publishEvent(t, event)
+	ticket := CreateRandomTicket(t, user, event)
+	defer func() {
+		err := provider.DeleteTicket(context.Background(), model.DeleteTicketParams{
+			UserID:   user.ID,
+			TicketID: ticket.ID,
+			EventID:  event.ID,
+		})
+		require.NoError(t, err)
+
+		err = provider.DeleteEvent(context.Background(), event.ID)
+		require.NoError(t, err)
+
+		err = provider.DeleteUser(context.Background(), user.ID)
+		require.NoError(t, err)
+
+		err = provider.DeleteUser(context.Background(), host.ID)
+		require.NoError(t, err)
+	}()
+
+	remind := func() map[int64][]int64 {
+		holders := make(map[int64][]int64)
+		_, err := provider.QueueEventReminders(context.Background(), model.QueueEventRemindersParams{
+			Now:          time.Now().UTC(),
+			StartsBefore: time.Now().Add(24 * time.Hour).UTC(),
+			BuildMessages: func(event *model.Event, userIDs []int64) ([]model.CreateOutboxMessageParams, error) {
+				holders[event.ID] = userIDs
+				return []model.CreateOutboxMessageParams{{
+					TaskID:   util.RandomString(16),
+					TaskType: "task:test",
+					Payload:  []byte(`{}`),
+					Queue:    "default",
+					MaxRetry: 1,
+				}}, nil
+			},
+		})
+		require.NoError(t, err)
+		return holders
+	}
+
+	holders := remind()
+	require.Equal(t, []int64{user.ID}, holders[event.ID])
+
+	// An event is only reminded once
+	holders = remind()
+	require.NotContains(t, holders, event.ID)
+}
+
+func TestUpdateEvent(t *testing.T) {
+	host := CreateRandomUser(t)
+	otherHost := CreateRandomUser(t)
+	event := CreateRandomEvent(t, host)
+	defer func() {
+		err := provider.DeleteEvent(context.Background(), event.ID)
+		require.NoError(t, err)
+
+		err = provider.DeleteUser(context.Background(), otherHost.ID)
+		require.NoError(t, err)
+
+		err = provider.DeleteUser(context.Background(), host.ID)
+		require.NoError(t, err)
+	}()
+
+	name := util.RandomName()
+	totalTickets := event.TotalTickets + 10
+	endDate := event.EndDate.Add(time.Hour)
+	updated, err := provider.UpdateEvent(context.Background(), model.UpdateEventParams{
+		EventID:      event.ID,
+		HostID:       host.ID,
+		Name:         &name,
+		TotalTickets: &totalTickets,
+		EndDate:      &endDate,
+	})
+	require.NoError(t, err)
+	require.Equal(t, name, updated.Name)
+	require.Equal(t, event.Description, updated.Description)
+	require.Equal(t, totalTickets, updated.TotalTickets)
+	require.Equal(t, totalTickets, updated.LeftTickets)
+	require.WithinDuration(t, endDate, updated.EndDate, time.Second)
+
+	fetched, err := provider.GetEvent(context.Background(), model.GetEventParams{EventID: event.ID})
+	require.NoError(t, err)
+	require.Equal(t, name, fetched.Name)
+	require.Equal(t, totalTickets, fetched.LeftTickets)
+
+	_, err = provider.UpdateEvent(context.Background(), model.UpdateEventParams{
+		EventID: event.ID,
+		HostID:  otherHost.ID,
+		Name:    &name,
+	})
+	require.ErrorIs(t, err, model.ErrEventNotOwned)
+
+	startDate := updated.EndDate.Add(time.Hour)
+	_, err = provider.UpdateEvent(context.Background(), model.UpdateEventParams{
+		EventID:   event.ID,
+		HostID:    host.ID,
+		StartDate: &startDate,
+	})
+	require.ErrorIs(t, err, model.ErrInvalidEventDates)
+
+	_, err = provider.UpdateEvent(context.Background(), model.UpdateEventParams{
+		EventID: 0,
+		HostID:  host.ID,
+		Name:    &name,
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestUpdateEventWithTicketsSold(t *testing.T) {
+	host := CreateRandomUser(t)
+	user := CreateRandomUser(t)
+	event := CreateRandomEvent(t, host)
+	ticket := CreateRandomTicket(t, user, event)
+	defer func() {
+		err := provider.DeleteTicket(context.Background(), model.DeleteTicketParams{
+			UserID:   user.ID,
+			TicketID: ticket.ID,
+			EventID:  event.ID,
+		})
+		require.NoError(t, err)
+
+		err = provider.DeleteEvent(context.Background(), event.ID)
+		require.NoError(t, err)
+
+		err = provider.DeleteUser(context.Background(), user.ID)
+		require.NoError(t, err)
+
+		err = provider.DeleteUser(context.Background(), host.ID)
+		require.NoError(t, err)
+	}()
+
+	name := util.RandomName()
+	_, err := provider.UpdateEvent(context.Background(), model.UpdateEventParams{
+		EventID: event.ID,
+		HostID:  host.ID,
+		Name:    &name,
+	})
+	require.ErrorIs(t, err, model.ErrEventTicketsSold)
+
+	var notified []int64
+	description := util.RandomString(20)
+	updated, err := provider.UpdateEventTx(context.Background(), model.UpdateEventTxParams{
+		UpdateEventParams: model.UpdateEventParams{
+			EventID:     event.ID,
+			HostID:      host.ID,
+			Description: &description,
+			// Unchanged fields are fine
+			Name: &event.Name,
+		},
+		AfterUpdate: func(event *model.Event, userIDs []int64) ([]model.CreateOutboxMessageParams, error) {
+			notified = userIDs
+			return nil, nil
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, description, updated.Description)
+	require.Equal(t, event.LeftTickets-ticket.Quantity, updated.LeftTickets)
+	require.Equal(t, []int64{user.ID}, notified)
+}
+
+func TestCancelEvent(t *testing.T) {
+	host := CreateRandomUser(t)
+	otherHost := CreateRandomUser(t)
+	user := CreateRandomUser(t)
+	event, err := provider.CreateEvent(context.Background(), model.CreateEventParams{
+		HostID:                  host.ID,
+		Name:                    util.RandomName(),
+		Description:             util.RandomString(10),
+		Location:                util.RandomString(10),
+		TotalTickets:            10,
+		StartDate:               time.Now().Add(2 * time.Hour).UTC(),
+		EndDate:                 time.Now().Add(4 * time.Hour).UTC(),
+		CancellationCutoffHours: 24,
+	})
+	require.NoError(t, err)
+	require.Equal(t, model.EventStatus_Draft, event.Status)
+	event = publishEvent(t, event)
+	require.Equal(t, model.EventStatus_Published, event.Status)
+
+	var tickets []*model.Ticket
+	for _, buyer := range []*model.User{user, otherHost} {
+		ticket, err := provider.CreateTicket(context.Background(), model.CreateTicketParams{
+			UserID:   buyer.ID,
+			EventID:  event.ID,
+			Quantity: 2,
+		})
+		require.NoError(t, err)
+		tickets = append(tickets, ticket)
+	}
+	defer func() {
+		for _, ticket := range tickets {
+			err := provider.DeleteTicket(context.Background(), model.DeleteTicketParams{
+				UserID:   ticket.UserID,
+				TicketID: ticket.ID,
+				EventID:  event.ID,
+			})
+			require.NoError(t, err)
+		}
+
+		err = provider.DeleteEvent(context.Background(), event.ID)
+		require.NoError(t, err)
+
+		for _, u := range []*model.User{user, otherHost, host} {
+			err = provider.DeleteUser(context.Background(), u.ID)
+			require.NoError(t, err)
+		}
+	}()
+
+	_, err = provider.CancelEvent(context.Background(), model.CancelEventParams{
+		EventID: event.ID,
+		HostID:  otherHost.ID,
+	})
+	require.ErrorIs(t, err, model.ErrEventNotOwned)
+
+	var notified []int64
+	cancelled, err := provider.CancelEventTx(context.Background(), model.CancelEventTxParams{
+		CancelEventParams: model.CancelEventParams{
+			EventID: event.ID,
+			HostID:  host.ID,
+		},
+		AfterCancel: func(event *model.Event, userIDs []int64) ([]model.CreateOutboxMessageParams, error) {
+			notified = userIDs
+			return nil, nil
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, model.EventStatus_Cancelled, cancelled.Status)
+	require.Equal(t, event.TotalTickets, cancelled.LeftTickets)
+	require.ElementsMatch(t, []int64{user.ID, otherHost.ID}, notified)
+
+	// The event stays readable and every ticket is refunded, even past the cutoff
+	fetched, err := provider.GetEvent(context.Background(), model.GetEventParams{EventID: event.ID})
+	require.NoError(t, err)
+	require.Equal(t, model.EventStatus_Cancelled, fetched.Status)
+	require.Equal(t, event.TotalTickets, fetched.LeftTickets)
+
+	for _, ticket := range tickets {
+		_, err = provider.CancelTicket(context.Background(), model.CancelTicketParams{
+			UserID:   ticket.UserID,
+			TicketID: ticket.ID,
+		})
+		require.ErrorIs(t, err, model.ErrTicketAlreadyCancelled)
+	}
+
+	_, err = provider.CancelEvent(context.Background(), model.CancelEventParams{
+		EventID: event.ID,
+		HostID:  host.ID,
+	})
+	require.ErrorIs(t, err, model.ErrEventCancelled)
+
+	_, err = provider.CreateTicket(context.Background(), model.CreateTicketParams{
+		UserID:   user.ID,
+		EventID:  event.ID,
+		Quantity: 1,
+	})
+	require.ErrorIs(t, err, model.ErrEventCancelled)
+
+	description := util.RandomString(20)
+	_, err = provider.UpdateEvent(context.Background(), model.UpdateEventParams{
+		EventID:     event.ID,
+		HostID:      host.ID,
+		Description: &description,
+	})
+	require.ErrorIs(t, err, model.ErrEventCancelled)
+}
+
+func TestUpdateEventStatus(t *testing.T) {
+	host := CreateRandomUser(t)
+	user := CreateRandomUser(t)
+	event, err := provider.CreateEvent(context.Background(), model.CreateEventParams{
+		HostID:       host.ID,
+		Name:         util.RandomName(),
+		Description:  util.RandomString(10),
+		Location:     util.RandomString(10),
+		TotalTickets: 10,
+		StartDate:    time.Now().Add(2 * time.Hour).UTC(),
+		EndDate:      time.Now().Add(4 * time.Hour).UTC(),
+	})
+	require.NoError(t, err)
+	defer func() {
+		err = provider.DeleteEvent(context.Background(), event.ID)
+		require.NoError(t, err)
+
+		err = provider.DeleteUser(context.Background(), user.ID)
+		require.NoError(t, err)
+
+		err = provider.DeleteUser(context.Background(), host.ID)
+		require.NoError(t, err)
+	}()
+
+	// Drafts are hidden from the public and cannot be bought
+	_, err = provider.GetEvent(context.Background(), model.GetEventParams{EventID: event.ID, Published: true})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	_, err = provider.GetEvent(context.Background(), model.GetEventParams{EventID: event.ID})
+	require.NoError(t, err)
+
+	hostEvents, err := provider.ListEvents(context.Background(), model.ListEventsParams{HostID: host.ID, Published: true, Limit: 10})
+	require.NoError(t, err)
+	require.Empty(t, hostEvents.Records)
+
+	_, err = provider.CreateTicket(context.Background(), model.CreateTicketParams{UserID: user.ID, EventID: event.ID, Quantity: 1})
+	require.ErrorIs(t, err, model.ErrEventNotPublished)
+
+	_, err = provider.UpdateEventStatus(context.Background(), model.UpdateEventStatusParams{
+		EventID: event.ID,
+		HostID:  user.ID,
+		Status:  model.EventStatus_Published,
+	})
+	require.ErrorIs(t, err, model.ErrEventNotOwned)
+
+	published, err := provider.UpdateEventStatus(context.Background(), model.UpdateEventStatusParams{
+		EventID: event.ID,
+		HostID:  host.ID,
+		Status:  model.EventStatus_Published,
+	})
+	require.NoError(t, err)
+	require.Equal(t, model.EventStatus_Published, published.Status)
+
+	fetched, err := provider.GetEvent(context.Background(), model.GetEventParams{EventID: event.ID, Published: true})
+	require.NoError(t, err)
+	require.Equal(t, model.EventStatus_Published, fetched.Status)
+
+	hostEvents, err = provider.ListEvents(context.Background(), model.ListEventsParams{HostID: host.ID, Published: true, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, hostEvents.Records, 1)
+
+	// Cancelling refunds tickets, so it is not a plain status change
+	_, err = provider.UpdateEventStatus(context.Background(), model.UpdateEventStatusParams{
+		EventID: event.ID,
+		HostID:  host.ID,
+		Status:  model.EventStatus_Cancelled,
+	})
+	require.ErrorIs(t, err, model.ErrInvalidStatusTransition)
+
+	ticket, err := provider.CreateTicket(context.Background(), model.CreateTicketParams{UserID: user.ID, EventID: event.ID, Quantity: 1})
+	require.NoError(t, err)
+
+	_, err = provider.UpdateEventStatus(context.Background(), model.UpdateEventStatusParams{
+		EventID: event.ID,
+		HostID:  host.ID,
+		Status:  model.EventStatus_Draft,
+	})
+	require.ErrorIs(t, err, model.ErrEventTicketsSold)
+
+	err = provider.DeleteTicket(context.Background(), model.DeleteTicketParams{
+		UserID:   user.ID,
+		TicketID: ticket.ID,
+		EventID:  event.ID,
+	})
+	require.NoError(t, err)
+
+	unpublished, err := provider.UpdateEventStatus(context.Background(), model.UpdateEventStatusParams{
+		EventID: event.ID,
+		HostID:  host.ID,
+		Status:  model.EventStatus_Draft,
+	})
+	require.NoError(t, err)
+	require.Equal(t, model.EventStatus_Draft, unpublished.Status)
+}
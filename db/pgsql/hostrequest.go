@@ -102,11 +102,21 @@ func (p *Provider) DeleteRequestToBecomeHost(context context.Context, id int64)
 	return err
 }
 
-func (p *Provider) ApproveDisapproveRequestToBecomeHost(ctx context.Context, request model.ApproveDisapproveRequestToBecomeHostParams) error {
+// ApproveDisapproveRequestToBecomeHost approves or rejects a pending request
+// and returns the updated request. Approving a request makes its user a host.
+func (p *Provider) ApproveDisapproveRequestToBecomeHost(ctx context.Context, request model.ApproveDisapproveRequestToBecomeHostParams) (*model.UserHostRequest, error) {
+	return p.ApproveDisapproveRequestToBecomeHostTx(ctx, model.ApproveDisapproveRequestToBecomeHostTxParams{
+		ApproveDisapproveRequestToBecomeHostParams: request,
+	})
+}
+
+// ApproveDisapproveRequestToBecomeHostTx decides on a pending request and writes
+// the outbox messages returned by request.AfterUpdate in one transaction
+func (p *Provider) ApproveDisapproveRequestToBecomeHostTx(ctx context.Context, request model.ApproveDisapproveRequestToBecomeHostTxParams) (*model.UserHostRequest, error) {
 	// Begin a transaction
 	txProvider, err := p.BeginTx(ctx, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	defer func() {
@@ -122,41 +132,86 @@ func (p *Provider) ApproveDisapproveRequestToBecomeHost(ctx context.Context, req
 		SELECT status FROM user_host_requests WHERE id = $1 FOR UPDATE
 	`, request.RequestID).Scan(&requestStatus)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if requestStatus != model.UserHostRequestStatus_Pending {
-		return errors.New("the request status is no longer pending")
+		err = errors.New("the request status is no longer pending")
+		return nil, err
 	}
 
+	var updated model.UserHostRequest
 	if request.Approved {
-		var userID int64
-
-		err := txProvider.tx.QueryRowContext(ctx, `
+		err = txProvider.tx.QueryRowContext(ctx, `
 			UPDATE user_host_requests SET status = $1, moderator_id = $2, updated_at = $3 WHERE id = $4
-			RETURNING user_id
-		`, model.UserHostRequestStatus_Approved, request.ModeratorID, time.Now(), request.RequestID).Scan(&userID)
+			RETURNING id, user_id, moderator_id, status, created_at, updated_at
+		`, model.UserHostRequestStatus_Approved, request.ModeratorID, time.Now(), request.RequestID).Scan(
+			&updated.ID,
+			&updated.UserID,
+			&updated.ModeratorID,
+			&updated.Status,
+			&updated.CreatedAt,
+			&updated.UpdatedAt,
+		)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		_, err = txProvider.tx.ExecContext(ctx, `
 			UPDATE users SET role = $1 WHERE id = $2
-			`, model.UserRole_Host, userID)
+			`, model.UserRole_Host, updated.UserID)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 	} else {
-		_, err = txProvider.tx.ExecContext(ctx, `
-			UPDATE user_host_requests SET status = $1 WHERE id = $2
-			`, model.UserHostRequestStatus_Rejected, request.RequestID)
+		err = txProvider.tx.QueryRowContext(ctx, `
+			UPDATE user_host_requests SET status = $1, updated_at = $2 WHERE id = $3
+			RETURNING id, user_id, moderator_id, status, created_at, updated_at
+			`, model.UserHostRequestStatus_Rejected, time.Now(), request.RequestID).Scan(
+			&updated.ID,
+			&updated.UserID,
+			&updated.ModeratorID,
+			&updated.Status,
+			&updated.CreatedAt,
+			&updated.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if request.AfterUpdate != nil {
+		var messages []model.CreateOutboxMessageParams
+		messages, err = request.AfterUpdate(&updated)
+		if err != nil {
+			return nil, err
+		}
+
+		err = createOutboxMessages(ctx, txProvider.tx, messages)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Commit the transaction
-	if err := txProvider.tx.Commit(); err != nil {
-		return err
+	if err = txProvider.tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// ExpireHostRequests deletes pending requests nobody decided on and rejected
+// requests old enough for their users to ask again
+func (p *Provider) ExpireHostRequests(context context.Context, arg model.ExpireHostRequestsParams) (int64, error) {
+	result, err := p.conn.ExecContext(context, `
+		DELETE FROM user_host_requests
+		WHERE (status = $1 AND created_at < $2) OR (status = $3 AND updated_at < $4)
+		`, model.UserHostRequestStatus_Pending, arg.PendingBefore, model.UserHostRequestStatus_Rejected, arg.RejectedBefore)
+	if err != nil {
+		return 0, err
 	}
 
-	return nil
+	return result.RowsAffected()
 }
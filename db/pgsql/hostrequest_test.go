@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/yashagw/event-management-api/db/model"
@@ -101,12 +102,14 @@ func TestApproveDisapproveRequestToBecomeHost(t *testing.T) {
 	}()
 
 	moderatorId := CreateRandomUser(t).ID
-	err = provider.ApproveDisapproveRequestToBecomeHost(context.Background(), model.ApproveDisapproveRequestToBecomeHostParams{
+	approved, err := provider.ApproveDisapproveRequestToBecomeHost(context.Background(), model.ApproveDisapproveRequestToBecomeHostParams{
 		Approved:    true,
 		RequestID:   request.ID,
 		ModeratorID: moderatorId,
 	})
 	require.NoError(t, err)
+	require.Equal(t, user.ID, approved.UserID)
+	require.Equal(t, model.UserHostRequestStatus_Approved, approved.Status)
 
 	r, err := provider.GetRequestToBecomeHost(context.Background(), user.ID)
 	require.NoError(t, err)
@@ -128,12 +131,14 @@ func TestApproveDisapproveRequestToBecomeHost(t *testing.T) {
 		require.NoError(t, err)
 	}()
 
-	err = provider.ApproveDisapproveRequestToBecomeHost(context.Background(), model.ApproveDisapproveRequestToBecomeHostParams{
+	rejected, err := provider.ApproveDisapproveRequestToBecomeHost(context.Background(), model.ApproveDisapproveRequestToBecomeHostParams{
 		Approved:    false,
 		RequestID:   request2.ID,
 		ModeratorID: moderatorId,
 	})
 	require.NoError(t, err)
+	require.Equal(t, user2.ID, rejected.UserID)
+	require.Equal(t, model.UserHostRequestStatus_Rejected, rejected.Status)
 
 	r, err = provider.GetRequestToBecomeHost(context.Background(), user2.ID)
 	require.NoError(t, err)
@@ -144,3 +149,34 @@ func TestApproveDisapproveRequestToBecomeHost(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, model.UserRole_User, u2.Role)
 }
+
+func TestExpireHostRequests(t *testing.T) {
+	user := CreateRandomUser(t)
+	defer func() {
+		err := provider.DeleteUser(context.Background(), user.ID)
+		require.NoError(t, err)
+	}()
+
+	request, err := provider.CreateRequestToBecomeHost(context.Background(), user.ID)
+	require.NoError(t, err)
+
+	// Requests created after the cutoff are kept
+	_, err = provider.ExpireHostRequests(context.Background(), model.ExpireHostRequestsParams{
+		PendingBefore:  request.CreatedAt.Add(-time.Minute),
+		RejectedBefore: request.CreatedAt.Add(-time.Minute),
+	})
+	require.NoError(t, err)
+
+	_, err = provider.GetRequestToBecomeHost(context.Background(), user.ID)
+	require.NoError(t, err)
+
+	expired, err := provider.ExpireHostRequests(context.Background(), model.ExpireHostRequestsParams{
+		PendingBefore:  request.CreatedAt.Add(time.Minute),
+		RejectedBefore: request.CreatedAt.Add(-time.Minute),
+	})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, expired, int64(1))
+
+	_, err = provider.GetRequestToBecomeHost(context.Background(), user.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
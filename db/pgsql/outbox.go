@@ -0,0 +1,124 @@
+package pgsql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/yashagw/event-management-api/db/model"
+)
+
+// createOutboxMessages writes messages to the outbox as part of tx, they are only
+// visible to the relay once tx commits
+func createOutboxMessages(ctx context.Context, tx *sql.Tx, messages []model.CreateOutboxMessageParams) error {
+	for _, message := range messages {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO outbox (task_id, task_type, payload, queue, max_retry, timeout_seconds)
+			VALUES ($1, $2, $3, $4, $5, $6)
+		`, message.TaskID, message.TaskType, string(message.Payload), message.Queue, message.MaxRetry, int64(message.Timeout/time.Second))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DispatchOutboxMessages locks the pending messages that are due and hands each
+// of them to arg.Dispatch. Dispatched messages are marked as such, the others keep
+// their error and are picked up again once arg.RetryDelay has passed, so a message
+// that keeps failing does not hold back the ones behind it. Rows locked by another
+// relay are skipped, so several relays can run at the same time.
+func (p *Provider) DispatchOutboxMessages(ctx context.Context, arg model.DispatchOutboxMessagesParams) (int, error) {
+	txProvider, err := p.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() {
+		if err != nil {
+			txProvider.tx.Rollback()
+		}
+		txProvider.Close()
+	}()
+
+	rows, err := txProvider.tx.QueryContext(ctx, `
+		SELECT id, task_id, task_type, payload, queue, max_retry, timeout_seconds, attempts, last_error, next_attempt_at, created_at, dispatched_at
+		FROM outbox
+		WHERE dispatched_at IS NULL AND next_attempt_at <= now() AND attempts < $2
+		ORDER BY next_attempt_at, id
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	`, arg.Limit, arg.MaxAttempts)
+	if err != nil {
+		return 0, err
+	}
+
+	var messages []*model.OutboxMessage
+	for rows.Next() {
+		var message model.OutboxMessage
+		var timeoutSeconds int64
+		err = rows.Scan(
+			&message.ID,
+			&message.TaskID,
+			&message.TaskType,
+			&message.Payload,
+			&message.Queue,
+			&message.MaxRetry,
+			&timeoutSeconds,
+			&message.Attempts,
+			&message.LastError,
+			&message.NextAttemptAt,
+			&message.CreatedAt,
+			&message.DispatchedAt,
+		)
+		if err != nil {
+			rows.Close()
+			return 0, err
+		}
+		message.Timeout = time.Duration(timeoutSeconds) * time.Second
+		messages = append(messages, &message)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+
+	dispatched := 0
+	for _, message := range messages {
+		if dispatchErr := arg.Dispatch(message); dispatchErr != nil {
+			retryDelay := arg.RetryDelay(message.Attempts + 1)
+			_, err = txProvider.tx.ExecContext(ctx, `
+				UPDATE outbox
+				SET attempts = attempts + 1, last_error = $1, next_attempt_at = now() + make_interval(secs => $2)
+				WHERE id = $3
+			`, dispatchErr.Error(), retryDelay.Seconds(), message.ID)
+		} else {
+			dispatched++
+			_, err = txProvider.tx.ExecContext(ctx, `
+				UPDATE outbox SET attempts = attempts + 1, last_error = NULL, dispatched_at = now() WHERE id = $1
+			`, message.ID)
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if err = txProvider.tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return dispatched, nil
+}
+
+// DeleteDispatchedOutboxMessages deletes messages dispatched before the given time
+func (p *Provider) DeleteDispatchedOutboxMessages(ctx context.Context, before time.Time) (int64, error) {
+	result, err := p.conn.ExecContext(ctx, `
+		DELETE FROM outbox WHERE dispatched_at < $1
+	`, before)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
@@ -0,0 +1,146 @@
+package pgsql
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/util"
+)
+
+func createUserWithOutboxMessage(t *testing.T, taskID string) (*model.User, error) {
+	hashedPassword, err := util.HashPassword(util.RandomString(6))
+	require.NoError(t, err)
+
+	return provider.CreateUserTx(context.Background(), model.CreateUserTxParams{
+		CreateUserParams: model.CreateUserParams{
+			Name:           util.RandomName(),
+			Email:          util.RandomEmail(),
+			HashedPassword: hashedPassword,
+		},
+		AfterCreate: func(user *model.User, verifyEmail *model.VerifyEmail) ([]model.CreateOutboxMessageParams, error) {
+			return []model.CreateOutboxMessageParams{{
+				TaskID:   taskID,
+				TaskType: "task:test",
+				Payload:  []byte(`{"email":"` + user.Email + `"}`),
+				Queue:    "default",
+				MaxRetry: 3,
+				Timeout:  10 * time.Second,
+			}}, nil
+		},
+	})
+}
+
+// dispatchTask relays every message that is due and returns the one with taskID,
+// if any. Failed messages are due again after retryDelay.
+func dispatchTask(t *testing.T, taskID string, dispatchErr error, retryDelay time.Duration) *model.OutboxMessage {
+	var found *model.OutboxMessage
+	_, err := provider.DispatchOutboxMessages(context.Background(), model.DispatchOutboxMessagesParams{
+		Limit:       1000,
+		MaxAttempts: 2,
+		RetryDelay: func(attempts int) time.Duration {
+			return retryDelay
+		},
+		Dispatch: func(message *model.OutboxMessage) error {
+			if message.TaskID != taskID {
+				return errors.New("not dispatched by this test")
+			}
+			found = message
+			return dispatchErr
+		},
+	})
+	require.NoError(t, err)
+
+	return found
+}
+
+func TestCreateUserTxWritesOutbox(t *testing.T) {
+	taskID := util.RandomString(16)
+	user, err := createUserWithOutboxMessage(t, taskID)
+	require.NoError(t, err)
+	defer func() {
+		err := provider.DeleteUser(context.Background(), user.ID)
+		require.NoError(t, err)
+	}()
+
+	// A failed dispatch keeps the message pending
+	message := dispatchTask(t, taskID, errors.New("redis is down"), 0)
+	require.NotNil(t, message)
+	require.Equal(t, "task:test", message.TaskType)
+	require.JSONEq(t, `{"email":"`+user.Email+`"}`, string(message.Payload))
+	require.Equal(t, "default", message.Queue)
+	require.Equal(t, 3, message.MaxRetry)
+	require.Equal(t, 10*time.Second, message.Timeout)
+	require.Equal(t, 0, message.Attempts)
+
+	message = dispatchTask(t, taskID, nil, 0)
+	require.NotNil(t, message)
+	require.Equal(t, 1, message.Attempts)
+	require.True(t, message.LastError.Valid)
+	require.Equal(t, "redis is down", message.LastError.String)
+
+	// Once dispatched it is not handed out again
+	message = dispatchTask(t, taskID, nil, 0)
+	require.Nil(t, message)
+}
+
+func TestDispatchOutboxMessagesBackoff(t *testing.T) {
+	taskID := util.RandomString(16)
+	user, err := createUserWithOutboxMessage(t, taskID)
+	require.NoError(t, err)
+	defer func() {
+		err := provider.DeleteUser(context.Background(), user.ID)
+		require.NoError(t, err)
+	}()
+
+	// A failed message waits for its retry delay
+	message := dispatchTask(t, taskID, errors.New("redis is down"), time.Hour)
+	require.NotNil(t, message)
+	message = dispatchTask(t, taskID, nil, 0)
+	require.Nil(t, message)
+}
+
+func TestDispatchOutboxMessagesMaxAttempts(t *testing.T) {
+	taskID := util.RandomString(16)
+	user, err := createUserWithOutboxMessage(t, taskID)
+	require.NoError(t, err)
+	defer func() {
+		err := provider.DeleteUser(context.Background(), user.ID)
+		require.NoError(t, err)
+	}()
+
+	message := dispatchTask(t, taskID, errors.New("redis is down"), 0)
+	require.NotNil(t, message)
+	message = dispatchTask(t, taskID, errors.New("redis is down"), 0)
+	require.NotNil(t, message)
+	require.Equal(t, 1, message.Attempts)
+
+	// It is left in the outbox once its attempts are used up
+	message = dispatchTask(t, taskID, nil, 0)
+	require.Nil(t, message)
+}
+
+func TestCreateUserTxRollsBack(t *testing.T) {
+	hashedPassword, err := util.HashPassword(util.RandomString(6))
+	require.NoError(t, err)
+
+	arg := model.CreateUserTxParams{
+		CreateUserParams: model.CreateUserParams{
+			Name:           util.RandomName(),
+			Email:          util.RandomEmail(),
+			HashedPassword: hashedPassword,
+		},
+		AfterCreate: func(user *model.User, verifyEmail *model.VerifyEmail) ([]model.CreateOutboxMessageParams, error) {
+			return nil, errors.New("cannot build message")
+		},
+	}
+	_, err = provider.CreateUserTx(context.Background(), arg)
+	require.Error(t, err)
+
+	// Neither the user nor its messages are written
+	_, err = provider.GetUserByEmail(context.Background(), arg.Email)
+	require.Error(t, err)
+}
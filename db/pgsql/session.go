@@ -0,0 +1,158 @@
+package pgsql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yashagw/event-management-api/db/model"
+)
+
+const sessionColumns = `id, user_id, refresh_token, access_token_id, user_agent, client_ip, is_blocked, expires_at, created_at`
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanSession(row rowScanner) (*model.Session, error) {
+	session := &model.Session{}
+	err := row.Scan(
+		&session.ID,
+		&session.UserID,
+		&session.RefreshToken,
+		&session.AccessTokenID,
+		&session.UserAgent,
+		&session.ClientIP,
+		&session.IsBlocked,
+		&session.ExpiresAt,
+		&session.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// CreateSession stores a new session for a refresh token
+func (p *Provider) CreateSession(context context.Context, arg model.CreateSessionParams) (*model.Session, error) {
+	return scanSession(p.conn.QueryRowContext(context, `
+		INSERT INTO sessions (id, user_id, refresh_token, access_token_id, user_agent, client_ip, is_blocked, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING `+sessionColumns,
+		arg.ID, arg.UserID, arg.RefreshToken, arg.AccessTokenID, arg.UserAgent, arg.ClientIP, arg.IsBlocked, arg.ExpiresAt,
+	))
+}
+
+// GetSession gets a session by id
+func (p *Provider) GetSession(context context.Context, id uuid.UUID) (*model.Session, error) {
+	return scanSession(p.conn.QueryRowContext(context, `
+		SELECT `+sessionColumns+`
+		FROM sessions
+		WHERE id = $1
+	`, id))
+}
+
+// UpdateSessionAccessToken records the latest access token issued for a session,
+// so that it can be revoked when the session is logged out
+func (p *Provider) UpdateSessionAccessToken(context context.Context, arg model.UpdateSessionAccessTokenParams) error {
+	result, err := p.conn.ExecContext(context, `
+		UPDATE sessions SET access_token_id = $2 WHERE id = $1
+	`, arg.ID, arg.AccessTokenID)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// BlockSession blocks a session of the given user, it returns sql.ErrNoRows
+// when the session does not exist or belongs to another user
+func (p *Provider) BlockSession(context context.Context, arg model.BlockSessionParams) (*model.Session, error) {
+	return scanSession(p.conn.QueryRowContext(context, `
+		UPDATE sessions SET is_blocked = true
+		WHERE id = $1 AND user_id = $2
+		RETURNING `+sessionColumns,
+		arg.ID, arg.UserID,
+	))
+}
+
+// BlockUserSessions blocks every unexpired session of a user and returns them
+func (p *Provider) BlockUserSessions(context context.Context, userID int64) ([]model.Session, error) {
+	rows, err := p.conn.QueryContext(context, `
+		UPDATE sessions SET is_blocked = true
+		WHERE user_id = $1 AND is_blocked = false AND expires_at > now()
+		RETURNING `+sessionColumns,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions := []model.Session{}
+	for rows.Next() {
+		session, err := scanSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, *session)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// RevokeToken adds a token to the revocation list, revoking a token twice is a no-op
+func (p *Provider) RevokeToken(context context.Context, arg model.RevokeTokenParams) error {
+	_, err := p.conn.ExecContext(context, `
+		INSERT INTO revoked_tokens (id, user_id, expires_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (id) DO NOTHING
+	`, arg.ID, arg.UserID, arg.ExpiresAt)
+	return err
+}
+
+// IsTokenRevoked reports whether a token is in the revocation list
+func (p *Provider) IsTokenRevoked(context context.Context, id uuid.UUID) (bool, error) {
+	var revoked bool
+	err := p.conn.QueryRowContext(context, `
+		SELECT EXISTS (SELECT 1 FROM revoked_tokens WHERE id = $1)
+	`, id).Scan(&revoked)
+	return revoked, err
+}
+
+// DeleteExpiredSessions deletes sessions whose refresh token expired before the given time
+func (p *Provider) DeleteExpiredSessions(context context.Context, before time.Time) (int64, error) {
+	result, err := p.conn.ExecContext(context, `
+		DELETE FROM sessions WHERE expires_at < $1
+	`, before)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// DeleteExpiredRevokedTokens deletes revocations of tokens that expired before
+// the given time, an expired token is rejected without them
+func (p *Provider) DeleteExpiredRevokedTokens(context context.Context, before time.Time) (int64, error) {
+	result, err := p.conn.ExecContext(context, `
+		DELETE FROM revoked_tokens WHERE expires_at < $1
+	`, before)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
@@ -0,0 +1,213 @@
+package pgsql
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/util"
+)
+
+func CreateRandomSession(t *testing.T, user *model.User) *model.Session {
+	arg := model.CreateSessionParams{
+		ID:            uuid.New(),
+		UserID:        user.ID,
+		RefreshToken:  util.RandomString(32),
+		AccessTokenID: uuid.New(),
+		UserAgent:     util.RandomString(10),
+		ClientIP:      "127.0.0.1",
+		IsBlocked:     false,
+		ExpiresAt:     time.Now().Add(time.Hour),
+	}
+
+	session, err := provider.CreateSession(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.ID, session.ID)
+	require.Equal(t, arg.UserID, session.UserID)
+	require.Equal(t, arg.RefreshToken, session.RefreshToken)
+	require.Equal(t, arg.AccessTokenID, session.AccessTokenID.UUID)
+	require.Equal(t, arg.UserAgent, session.UserAgent)
+	require.Equal(t, arg.ClientIP, session.ClientIP)
+	require.False(t, session.IsBlocked)
+	require.WithinDuration(t, arg.ExpiresAt, session.ExpiresAt, time.Second)
+	require.NotEmpty(t, session.CreatedAt)
+
+	return session
+}
+
+func TestGetSession(t *testing.T) {
+	user := CreateRandomUser(t)
+	defer func() {
+		err := provider.DeleteUser(context.Background(), user.ID)
+		require.NoError(t, err)
+	}()
+
+	session := CreateRandomSession(t, user)
+
+	fetchedSession, err := provider.GetSession(context.Background(), session.ID)
+	require.NoError(t, err)
+	require.Equal(t, session.ID, fetchedSession.ID)
+	require.Equal(t, session.RefreshToken, fetchedSession.RefreshToken)
+	require.WithinDuration(t, session.ExpiresAt, fetchedSession.ExpiresAt, time.Second)
+
+	_, err = provider.GetSession(context.Background(), uuid.New())
+	require.Error(t, err)
+}
+
+func TestUpdateSessionAccessToken(t *testing.T) {
+	user := CreateRandomUser(t)
+	defer func() {
+		err := provider.DeleteUser(context.Background(), user.ID)
+		require.NoError(t, err)
+	}()
+
+	session := CreateRandomSession(t, user)
+
+	accessTokenID := uuid.New()
+	err := provider.UpdateSessionAccessToken(context.Background(), model.UpdateSessionAccessTokenParams{
+		ID:            session.ID,
+		AccessTokenID: accessTokenID,
+	})
+	require.NoError(t, err)
+
+	fetchedSession, err := provider.GetSession(context.Background(), session.ID)
+	require.NoError(t, err)
+	require.Equal(t, accessTokenID, fetchedSession.AccessTokenID.UUID)
+
+	err = provider.UpdateSessionAccessToken(context.Background(), model.UpdateSessionAccessTokenParams{
+		ID:            uuid.New(),
+		AccessTokenID: accessTokenID,
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestBlockSession(t *testing.T) {
+	user := CreateRandomUser(t)
+	otherUser := CreateRandomUser(t)
+	defer func() {
+		err := provider.DeleteUser(context.Background(), user.ID)
+		require.NoError(t, err)
+		err = provider.DeleteUser(context.Background(), otherUser.ID)
+		require.NoError(t, err)
+	}()
+
+	session := CreateRandomSession(t, user)
+
+	// A user cannot block the session of someone else
+	_, err := provider.BlockSession(context.Background(), model.BlockSessionParams{
+		ID:     session.ID,
+		UserID: otherUser.ID,
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	blockedSession, err := provider.BlockSession(context.Background(), model.BlockSessionParams{
+		ID:     session.ID,
+		UserID: user.ID,
+	})
+	require.NoError(t, err)
+	require.True(t, blockedSession.IsBlocked)
+	require.Equal(t, session.AccessTokenID, blockedSession.AccessTokenID)
+}
+
+func TestBlockUserSessions(t *testing.T) {
+	user := CreateRandomUser(t)
+	defer func() {
+		err := provider.DeleteUser(context.Background(), user.ID)
+		require.NoError(t, err)
+	}()
+
+	n := 3
+	for i := 0; i < n; i++ {
+		CreateRandomSession(t, user)
+	}
+
+	sessions, err := provider.BlockUserSessions(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Len(t, sessions, n)
+	for _, session := range sessions {
+		require.True(t, session.IsBlocked)
+		require.Equal(t, user.ID, session.UserID)
+	}
+
+	// Sessions already blocked are not returned again
+	sessions, err = provider.BlockUserSessions(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Empty(t, sessions)
+}
+
+func TestRevokeToken(t *testing.T) {
+	user := CreateRandomUser(t)
+	defer func() {
+		err := provider.DeleteUser(context.Background(), user.ID)
+		require.NoError(t, err)
+	}()
+
+	arg := model.RevokeTokenParams{
+		ID:        uuid.New(),
+		UserID:    user.ID,
+		ExpiresAt: time.Now().Add(time.Minute),
+	}
+
+	revoked, err := provider.IsTokenRevoked(context.Background(), arg.ID)
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	err = provider.RevokeToken(context.Background(), arg)
+	require.NoError(t, err)
+
+	// Revoking the same token again is a no-op
+	err = provider.RevokeToken(context.Background(), arg)
+	require.NoError(t, err)
+
+	revoked, err = provider.IsTokenRevoked(context.Background(), arg.ID)
+	require.NoError(t, err)
+	require.True(t, revoked)
+}
+
+func TestDeleteExpiredSessions(t *testing.T) {
+	user := CreateRandomUser(t)
+	defer func() {
+		err := provider.DeleteUser(context.Background(), user.ID)
+		require.NoError(t, err)
+	}()
+
+	session := CreateRandomSession(t, user)
+
+	_, err := provider.DeleteExpiredSessions(context.Background(), session.ExpiresAt.Add(-time.Minute))
+	require.NoError(t, err)
+	_, err = provider.GetSession(context.Background(), session.ID)
+	require.NoError(t, err)
+
+	deleted, err := provider.DeleteExpiredSessions(context.Background(), session.ExpiresAt.Add(time.Minute))
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, deleted, int64(1))
+	_, err = provider.GetSession(context.Background(), session.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestDeleteExpiredRevokedTokens(t *testing.T) {
+	user := CreateRandomUser(t)
+	defer func() {
+		err := provider.DeleteUser(context.Background(), user.ID)
+		require.NoError(t, err)
+	}()
+
+	arg := model.RevokeTokenParams{
+		ID:        uuid.New(),
+		UserID:    user.ID,
+		ExpiresAt: time.Now().Add(time.Minute),
+	}
+	err := provider.RevokeToken(context.Background(), arg)
+	require.NoError(t, err)
+
+	_, err = provider.DeleteExpiredRevokedTokens(context.Background(), arg.ExpiresAt.Add(time.Minute))
+	require.NoError(t, err)
+
+	revoked, err := provider.IsTokenRevoked(context.Background(), arg.ID)
+	require.NoError(t, err)
+	require.False(t, revoked)
+}
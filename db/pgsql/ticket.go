@@ -2,6 +2,8 @@ package pgsql
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -21,7 +23,206 @@ func (provider *Provider) GetTicket(context context.Context, request model.GetTi
 	return &ticket, nil
 }
 
+// ListTickets lists the tickets of a user together with their events,
+// cancelled tickets are listed too
+func (p *Provider) ListTickets(ctx context.Context, req model.ListTicketsParams) (*model.ListTicketsResponse, error) {
+	if req.Limit <= 0 {
+		req.Limit = 100
+	}
+
+	baseQuery := `
+		SELECT t.id, t.user_id, t.event_id, t.quantity, t.created_at,
+			e.id, e.host_id, e.name, e.description, e.location, e.total_tickets, e.left_tickets,
+			e.start_date, e.end_date, e.created_at, e.cancellation_cutoff_hours, e.status,
+			EXISTS (SELECT 1 FROM refunds WHERE refunds.ticket_id = t.id)
+		FROM tickets t
+		JOIN events e ON e.id = t.event_id`
+
+	filters := []string{"t.user_id = $3"}
+	args := []interface{}{req.UserID}
+
+	if req.Search != "" {
+		placeholder := "$" + fmt.Sprint(len(args)+3)
+		filters = append(filters, "(e.name ILIKE "+placeholder+" OR e.location ILIKE "+placeholder+")")
+		args = append(args, "%"+escapeLike(req.Search)+"%")
+	}
+
+	switch req.Period {
+	case model.TicketPeriod_Upcoming:
+		filters = append(filters, "e.end_date >= now()")
+	case model.TicketPeriod_Past:
+		filters = append(filters, "e.end_date < now()")
+	}
+
+	orderBy := "t.created_at DESC, t.id DESC"
+	if req.SortBy == model.TicketSortBy_EventStart {
+		orderBy = "e.start_date ASC, t.id ASC"
+	}
+
+	finalQuery := baseQuery + " WHERE " + strings.Join(filters, " AND ") + " ORDER BY " + orderBy + " LIMIT $1 OFFSET $2"
+	queryArgs := append([]interface{}{req.Limit, req.Offset}, args...)
+
+	rows, err := p.conn.QueryContext(ctx, finalQuery, queryArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tickets []model.UserTicket
+	nextOffset := req.Offset
+	now := time.Now()
+
+	for rows.Next() {
+		var ticket model.UserTicket
+		err := rows.Scan(
+			&ticket.ID,
+			&ticket.UserID,
+			&ticket.EventID,
+			&ticket.Quantity,
+			&ticket.CreatedAt,
+			&ticket.Event.ID,
+			&ticket.Event.HostID,
+			&ticket.Event.Name,
+			&ticket.Event.Description,
+			&ticket.Event.Location,
+			&ticket.Event.TotalTickets,
+			&ticket.Event.LeftTickets,
+			&ticket.Event.StartDate,
+			&ticket.Event.EndDate,
+			&ticket.Event.CreatedAt,
+			&ticket.Event.CancellationCutoffHours,
+			&ticket.Event.Status,
+			&ticket.Cancelled,
+		)
+		if err != nil {
+			return nil, err
+		}
+		ticket.Event.Status = ticket.Event.CurrentStatus(now)
+
+		tickets = append(tickets, ticket)
+		nextOffset++
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &model.ListTicketsResponse{
+		Records:    tickets,
+		NextOffset: nextOffset,
+	}, nil
+}
+
+// ListEventTickets lists the tickets sold for an event with their buyers, the
+// oldest purchase first
+func (p *Provider) ListEventTickets(ctx context.Context, req model.ListEventTicketsParams) (*model.ListEventTicketsResponse, error) {
+	if req.Limit <= 0 {
+		req.Limit = 100
+	}
+
+	rows, err := p.conn.QueryContext(ctx, `
+		SELECT t.id, t.user_id, t.event_id, t.quantity, t.created_at, u.name, u.email,
+			EXISTS (SELECT 1 FROM refunds WHERE refunds.ticket_id = t.id)
+		FROM tickets t
+		JOIN users u ON u.id = t.user_id
+		WHERE t.event_id = $1
+		ORDER BY t.created_at, t.id
+		LIMIT $2 OFFSET $3
+	`, req.EventID, req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tickets []model.EventTicket
+	nextOffset := req.Offset
+
+	for rows.Next() {
+		var ticket model.EventTicket
+		err := rows.Scan(
+			&ticket.ID,
+			&ticket.UserID,
+			&ticket.EventID,
+			&ticket.Quantity,
+			&ticket.CreatedAt,
+			&ticket.BuyerName,
+			&ticket.BuyerEmail,
+			&ticket.Cancelled,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		tickets = append(tickets, ticket)
+		nextOffset++
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &model.ListEventTicketsResponse{
+		Records:    tickets,
+		NextOffset: nextOffset,
+	}, nil
+}
+
+// GetEventSalesStats sums up the sales of an event, daily sales are bucketed
+// by the day the tickets were bought
+func (p *Provider) GetEventSalesStats(ctx context.Context, eventID int64) (*model.EventSalesStats, error) {
+	var stats model.EventSalesStats
+	err := p.conn.QueryRowContext(ctx, `
+		SELECT e.left_tickets,
+			COALESCE(SUM(t.quantity) FILTER (WHERE r.id IS NULL), 0),
+			COALESCE(SUM(t.quantity) FILTER (WHERE r.id IS NOT NULL), 0)
+		FROM events e
+		LEFT JOIN tickets t ON t.event_id = e.id
+		LEFT JOIN refunds r ON r.ticket_id = t.id
+		WHERE e.id = $1
+		GROUP BY e.id
+	`, eventID).Scan(&stats.Remaining, &stats.Sold, &stats.Cancelled)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := p.conn.QueryContext(ctx, `
+		SELECT date_trunc('day', t.created_at) AS day, SUM(t.quantity)
+		FROM tickets t
+		WHERE t.event_id = $1
+			AND NOT EXISTS (SELECT 1 FROM refunds WHERE refunds.ticket_id = t.id)
+		GROUP BY day
+		ORDER BY day
+	`, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stats.Daily = []model.DailySales{}
+	for rows.Next() {
+		var sales model.DailySales
+		if err := rows.Scan(&sales.Day, &sales.Tickets); err != nil {
+			return nil, err
+		}
+		stats.Daily = append(stats.Daily, sales)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
+// escapeLike makes the wildcards of a LIKE pattern match literally
+func escapeLike(pattern string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(pattern)
+}
+
 func (p *Provider) CreateTicket(ctx context.Context, req model.CreateTicketParams) (*model.Ticket, error) {
+	return p.CreateTicketTx(ctx, model.CreateTicketTxParams{CreateTicketParams: req})
+}
+
+// CreateTicketTx buys tickets for an event and writes the outbox messages
+// returned by req.AfterCreate in one transaction
+func (p *Provider) CreateTicketTx(ctx context.Context, req model.CreateTicketTxParams) (*model.Ticket, error) {
 	// Begin a transaction
 	txProvider, err := p.BeginTx(ctx, nil)
 	if err != nil {
@@ -36,16 +237,29 @@ func (p *Provider) CreateTicket(ctx context.Context, req model.CreateTicketParam
 	}()
 
 	// Check if there are enough tickets left for the event and lock the row
-	var leftTickets int64
+	var event model.Event
 	err = txProvider.tx.QueryRowContext(ctx,
-		"SELECT left_tickets FROM events WHERE id = $1 FOR UPDATE",
-		req.EventID).Scan(&leftTickets)
+		"SELECT left_tickets, start_date, end_date, status FROM events WHERE id = $1 FOR UPDATE",
+		req.EventID).Scan(&event.LeftTickets, &event.StartDate, &event.EndDate, &event.Status)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
-	if leftTickets < req.Quantity {
-		return nil, errors.New("not enough tickets left for the event")
+	switch event.CurrentStatus(time.Now()) {
+	case model.EventStatus_Draft:
+		err = model.ErrEventNotPublished
+		return nil, err
+	case model.EventStatus_Cancelled:
+		err = model.ErrEventCancelled
+		return nil, err
+	case model.EventStatus_Completed:
+		err = model.ErrEventEnded
+		return nil, err
+	}
+
+	if event.LeftTickets < req.Quantity {
+		err = model.ErrNotEnoughTickets
+		return nil, err
 	}
 
 	// Update the number of left tickets for the event
@@ -67,13 +281,6 @@ func (p *Provider) CreateTicket(ctx context.Context, req model.CreateTicketParam
 		return nil, err
 	}
 
-	// Commit the transaction
-	err = txProvider.tx.Commit()
-	if err != nil {
-		return nil, err
-	}
-
-	// Retrieve the created ticket
 	createdTicket := &model.Ticket{
 		ID:        ticketID,
 		UserID:    req.UserID,
@@ -82,9 +289,120 @@ func (p *Provider) CreateTicket(ctx context.Context, req model.CreateTicketParam
 		CreatedAt: createdAt,
 	}
 
+	if req.AfterCreate != nil {
+		var messages []model.CreateOutboxMessageParams
+		messages, err = req.AfterCreate(createdTicket)
+		if err != nil {
+			return nil, err
+		}
+
+		err = createOutboxMessages(ctx, txProvider.tx, messages)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Commit the transaction
+	err = txProvider.tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
 	return createdTicket, nil
 }
 
+func (p *Provider) CancelTicket(ctx context.Context, req model.CancelTicketParams) (*model.Refund, error) {
+	return p.CancelTicketTx(ctx, model.CancelTicketTxParams{CancelTicketParams: req})
+}
+
+// CancelTicketTx records a refund for a ticket, returns its quantity to the
+// event and writes the outbox messages returned by req.AfterCancel in one
+// transaction. The ticket row is kept.
+func (p *Provider) CancelTicketTx(ctx context.Context, req model.CancelTicketTxParams) (*model.Refund, error) {
+	// Begin a transaction
+	txProvider, err := p.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	defer func() {
+		if err != nil {
+			txProvider.tx.Rollback()
+		}
+		txProvider.Close()
+	}()
+
+	// Check if the ticket exists and lock it together with its event
+	refund := &model.Refund{
+		TicketID: req.TicketID,
+		UserID:   req.UserID,
+		Status:   model.RefundStatus_Pending,
+	}
+	var event model.Event
+	var cancelled bool
+	err = txProvider.tx.QueryRowContext(ctx, `
+		SELECT t.event_id, t.quantity, e.start_date, e.cancellation_cutoff_hours,
+			EXISTS (SELECT 1 FROM refunds WHERE refunds.ticket_id = t.id)
+		FROM tickets t
+		JOIN events e ON e.id = t.event_id
+		WHERE t.id = $1 AND t.user_id = $2
+		FOR UPDATE
+	`, req.TicketID, req.UserID).Scan(&refund.EventID, &refund.Quantity, &event.StartDate, &event.CancellationCutoffHours, &cancelled)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if cancelled {
+		err = model.ErrTicketAlreadyCancelled
+		return nil, err
+	}
+	if !time.Now().Before(event.CancellationDeadline()) {
+		err = model.ErrCancellationClosed
+		return nil, err
+	}
+
+	// Record the refund
+	err = txProvider.tx.QueryRowContext(ctx, `
+		INSERT INTO refunds (ticket_id, user_id, event_id, quantity, status)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at, updated_at
+	`, refund.TicketID, refund.UserID, refund.EventID, refund.Quantity, refund.Status).Scan(&refund.ID, &refund.CreatedAt, &refund.UpdatedAt)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// Update the number of left tickets for the event
+	_, err = txProvider.tx.ExecContext(ctx,
+		"UPDATE events SET left_tickets = left_tickets + $1 WHERE id = $2",
+		refund.Quantity, refund.EventID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if req.AfterCancel != nil {
+		var messages []model.CreateOutboxMessageParams
+		messages, err = req.AfterCancel(refund)
+		if err != nil {
+			return nil, err
+		}
+
+		err = createOutboxMessages(ctx, txProvider.tx, messages)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Commit the transaction
+	err = txProvider.tx.Commit()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return refund, nil
+}
+
+// DeleteTicket removes a ticket and its refund for good, the quantity of a
+// ticket that was not cancelled is returned to the event
 func (p *Provider) DeleteTicket(ctx context.Context, req model.DeleteTicketParams) error {
 	// Begin a transaction
 	txProvider, err := p.BeginTx(ctx, nil)
@@ -108,6 +426,18 @@ func (p *Provider) DeleteTicket(ctx context.Context, req model.DeleteTicketParam
 		return errors.WithStack(err)
 	}
 
+	// A cancelled ticket already returned its quantity
+	result, err := txProvider.tx.ExecContext(ctx,
+		"DELETE FROM refunds WHERE ticket_id = $1",
+		req.TicketID)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	refunded, err := result.RowsAffected()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
 	// Delete the ticket
 	var quantity int64
 	err = txProvider.tx.QueryRowContext(ctx,
@@ -117,12 +447,14 @@ func (p *Provider) DeleteTicket(ctx context.Context, req model.DeleteTicketParam
 		return errors.WithStack(err)
 	}
 
-	// Update the number of left tickets for the event
-	_, err = txProvider.tx.ExecContext(ctx,
-		"UPDATE events SET left_tickets = left_tickets + $1 WHERE id = $2",
-		quantity, req.EventID)
-	if err != nil {
-		return errors.WithStack(err)
+	if refunded == 0 {
+		// Update the number of left tickets for the event
+		_, err = txProvider.tx.ExecContext(ctx,
+			"UPDATE events SET left_tickets = left_tickets + $1 WHERE id = $2",
+			quantity, req.EventID)
+		if err != nil {
+			return errors.WithStack(err)
+		}
 	}
 
 	// Commit the transaction
@@ -2,7 +2,9 @@ package pgsql
 
 import (
 	"context"
+	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/yashagw/event-management-api/db/model"
@@ -56,3 +58,358 @@ func TestCreateTicket(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, ticket, fetchedTicket)
 }
+
+func TestCreateTicketNotEnoughTickets(t *testing.T) {
+	user := CreateRandomUser(t)
+	event := CreateRandomEvent(t, user)
+	defer func() {
+		err := provider.DeleteEvent(context.Background(), event.ID)
+		require.NoError(t, err)
+
+		err = provider.DeleteUser(context.Background(), user.ID)
+		require.NoError(t, err)
+	}()
+
+	ticket, err := provider.CreateTicket(context.Background(), model.CreateTicketParams{
+		UserID:   user.ID,
+		EventID:  event.ID,
+		Quantity: event.LeftTickets + 1,
+	})
+	require.ErrorIs(t, err, model.ErrNotEnoughTickets)
+	require.Nil(t, ticket)
+
+	// The failed purchase leaves the event untouched
+	fetchedEvent, err := provider.GetEvent(context.Background(), model.GetEventParams{EventID: event.ID})
+	require.NoError(t, err)
+	require.Equal(t, event.LeftTickets, fetchedEvent.LeftTickets)
+}
+
+func TestCancelTicket(t *testing.T) {
+	host := CreateRandomUser(t)
+	user := CreateRandomUser(t)
+	event, err := provider.CreateEvent(context.Background(), model.CreateEventParams{
+		HostID:                  host.ID,
+		Name:                    util.RandomName(),
+		Description:             util.RandomString(10),
+		Location:                util.RandomString(10),
+		TotalTickets:            10,
+		StartDate:               time.Now().Add(48 * time.Hour).UTC(),
+		EndDate:                 time.Now().Add(50 * time.Hour).UTC(),
+		CancellationCutoffHours: 24,
+	})
+	require.NoError(t, err)
+	event = publishEvent(t, event)
+	require.Equal(t, int64(24), event.CancellationCutoffHours)
+
+	ticket := CreateRandomTicket(t, user, event)
+	defer func() {
+		err := provider.DeleteTicket(context.Background(), model.DeleteTicketParams{
+			UserID:   user.ID,
+			TicketID: ticket.ID,
+			EventID:  event.ID,
+		})
+		require.NoError(t, err)
+
+		err = provider.DeleteEvent(context.Background(), event.ID)
+		require.NoError(t, err)
+
+		err = provider.DeleteUser(context.Background(), user.ID)
+		require.NoError(t, err)
+
+		err = provider.DeleteUser(context.Background(), host.ID)
+		require.NoError(t, err)
+	}()
+
+	arg := model.CancelTicketParams{
+		UserID:   user.ID,
+		TicketID: ticket.ID,
+	}
+
+	var cancelled *model.Refund
+	refund, err := provider.CancelTicketTx(context.Background(), model.CancelTicketTxParams{
+		CancelTicketParams: arg,
+		AfterCancel: func(refund *model.Refund) ([]model.CreateOutboxMessageParams, error) {
+			cancelled = refund
+			return nil, nil
+		},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, refund.ID)
+	require.Equal(t, refund, cancelled)
+	require.Equal(t, ticket.ID, refund.TicketID)
+	require.Equal(t, user.ID, refund.UserID)
+	require.Equal(t, event.ID, refund.EventID)
+	require.Equal(t, ticket.Quantity, refund.Quantity)
+	require.Equal(t, model.RefundStatus_Pending, refund.Status)
+
+	// The ticket is kept and its quantity is back on sale
+	_, err = provider.GetTicket(context.Background(), model.GetTicketParams{UserID: user.ID, TicketID: ticket.ID})
+	require.NoError(t, err)
+	fetchedEvent, err := provider.GetEvent(context.Background(), model.GetEventParams{EventID: event.ID})
+	require.NoError(t, err)
+	require.Equal(t, event.TotalTickets, fetchedEvent.LeftTickets)
+
+	// Nor is the buyer reminded or notified about the event anymore
+	tx, err := provider.conn.Begin()
+	require.NoError(t, err)
+	holders, err := ticketHolders(context.Background(), tx, event.ID)
+	require.NoError(t, tx.Rollback())
+	require.NoError(t, err)
+	require.Empty(t, holders)
+
+	_, err = provider.CancelTicket(context.Background(), arg)
+	require.ErrorIs(t, err, model.ErrTicketAlreadyCancelled)
+
+	_, err = provider.CancelTicket(context.Background(), model.CancelTicketParams{
+		UserID:   host.ID,
+		TicketID: ticket.ID,
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestCancelTicketAfterCutoff(t *testing.T) {
+	host := CreateRandomUser(t)
+	user := CreateRandomUser(t)
+	event, err := provider.CreateEvent(context.Background(), model.CreateEventParams{
+		HostID:                  host.ID,
+		Name:                    util.RandomName(),
+		Description:             util.RandomString(10),
+		Location:                util.RandomString(10),
+		TotalTickets:            10,
+		StartDate:               time.Now().Add(12 * time.Hour).UTC(),
+		EndDate:                 time.Now().Add(14 * time.Hour).UTC(),
+		CancellationCutoffHours: 24,
+	})
+	require.NoError(t, err)
+	event = publishEvent(t, event)
+
+	ticket := CreateRandomTicket(t, user, event)
+	defer func() {
+		err := provider.DeleteTicket(context.Background(), model.DeleteTicketParams{
+			UserID:   user.ID,
+			TicketID: ticket.ID,
+			EventID:  event.ID,
+		})
+		require.NoError(t, err)
+
+		err = provider.DeleteEvent(context.Background(), event.ID)
+		require.NoError(t, err)
+
+		err = provider.DeleteUser(context.Background(), user.ID)
+		require.NoError(t, err)
+
+		err = provider.DeleteUser(context.Background(), host.ID)
+		require.NoError(t, err)
+	}()
+
+	_, err = provider.CancelTicket(context.Background(), model.CancelTicketParams{
+		UserID:   user.ID,
+		TicketID: ticket.ID,
+	})
+	require.ErrorIs(t, err, model.ErrCancellationClosed)
+}
+
+func TestListTickets(t *testing.T) {
+	host := CreateRandomUser(t)
+	user := CreateRandomUser(t)
+
+	newEvent := func(name, location string, start time.Time) *model.Event {
+		event, err := provider.CreateEvent(context.Background(), model.CreateEventParams{
+			HostID:       host.ID,
+			Name:         name,
+			Description:  util.RandomString(10),
+			Location:     location,
+			TotalTickets: 10,
+			StartDate:    start.UTC(),
+			EndDate:      start.Add(2 * time.Hour).UTC(),
+		})
+		require.NoError(t, err)
+		event = publishEvent(t, event)
+		return event
+	}
+
+	suffix := util.RandomString(8)
+	past := newEvent("Jazz Night "+suffix, "Berlin", time.Now().Add(-48*time.Hour))
+	later := newEvent("Rock Night "+suffix, "Paris", time.Now().Add(72*time.Hour))
+	soon := newEvent("Folk 100% "+suffix, "Berlin", time.Now().Add(24*time.Hour))
+	events := []*model.Event{past, later, soon}
+
+	var tickets []*model.Ticket
+	for _, event := range events {
+		tickets = append(tickets, CreateRandomTicket(t, user, event))
+	}
+	defer func() {
+		for i, ticket := range tickets {
+			err := provider.DeleteTicket(context.Background(), model.DeleteTicketParams{
+				UserID:   user.ID,
+				TicketID: ticket.ID,
+				EventID:  events[i].ID,
+			})
+			require.NoError(t, err)
+		}
+		for _, event := range events {
+			err := provider.DeleteEvent(context.Background(), event.ID)
+			require.NoError(t, err)
+		}
+
+		err := provider.DeleteUser(context.Background(), user.ID)
+		require.NoError(t, err)
+
+		err = provider.DeleteUser(context.Background(), host.ID)
+		require.NoError(t, err)
+	}()
+
+	ticketIDs := func(res *model.ListTicketsResponse) []int64 {
+		var ids []int64
+		for _, ticket := range res.Records {
+			ids = append(ids, ticket.ID)
+		}
+		return ids
+	}
+
+	// Latest purchase first by default
+	res, err := provider.ListTickets(context.Background(), model.ListTicketsParams{UserID: user.ID, Limit: 10})
+	require.NoError(t, err)
+	require.Equal(t, []int64{tickets[2].ID, tickets[1].ID, tickets[0].ID}, ticketIDs(res))
+	require.Equal(t, 3, res.NextOffset)
+	require.Equal(t, later.Name, res.Records[1].Event.Name)
+
+	res, err = provider.ListTickets(context.Background(), model.ListTicketsParams{
+		UserID: user.ID,
+		SortBy: model.TicketSortBy_EventStart,
+		Limit:  2,
+		Offset: 1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{tickets[2].ID, tickets[1].ID}, ticketIDs(res))
+	require.Equal(t, 3, res.NextOffset)
+
+	res, err = provider.ListTickets(context.Background(), model.ListTicketsParams{
+		UserID: user.ID,
+		Period: model.TicketPeriod_Upcoming,
+		SortBy: model.TicketSortBy_EventStart,
+		Limit:  10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{tickets[2].ID, tickets[1].ID}, ticketIDs(res))
+
+	res, err = provider.ListTickets(context.Background(), model.ListTicketsParams{
+		UserID: user.ID,
+		Period: model.TicketPeriod_Past,
+		Limit:  10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{tickets[0].ID}, ticketIDs(res))
+
+	res, err = provider.ListTickets(context.Background(), model.ListTicketsParams{
+		UserID: user.ID,
+		Search: "berlin",
+		SortBy: model.TicketSortBy_EventStart,
+		Limit:  10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{tickets[0].ID, tickets[2].ID}, ticketIDs(res))
+
+	// Wildcards in the search match literally
+	res, err = provider.ListTickets(context.Background(), model.ListTicketsParams{
+		UserID: user.ID,
+		Search: "100% " + suffix,
+		Limit:  10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{tickets[2].ID}, ticketIDs(res))
+
+	res, err = provider.ListTickets(context.Background(), model.ListTicketsParams{
+		UserID: host.ID,
+		Limit:  10,
+	})
+	require.NoError(t, err)
+	require.Empty(t, res.Records)
+}
+
+func TestListEventTickets(t *testing.T) {
+	host := CreateRandomUser(t)
+	buyer := CreateRandomUser(t)
+	event, err := provider.CreateEvent(context.Background(), model.CreateEventParams{
+		HostID:       host.ID,
+		Name:         util.RandomName(),
+		Description:  util.RandomString(10),
+		Location:     util.RandomString(10),
+		TotalTickets: 10,
+		StartDate:    time.Now().Add(48 * time.Hour).UTC(),
+		EndDate:      time.Now().Add(50 * time.Hour).UTC(),
+	})
+	require.NoError(t, err)
+	event = publishEvent(t, event)
+
+	var tickets []*model.Ticket
+	for _, quantity := range []int64{2, 3} {
+		ticket, err := provider.CreateTicket(context.Background(), model.CreateTicketParams{
+			UserID:   buyer.ID,
+			EventID:  event.ID,
+			Quantity: quantity,
+		})
+		require.NoError(t, err)
+		tickets = append(tickets, ticket)
+	}
+	defer func() {
+		for _, ticket := range tickets {
+			err := provider.DeleteTicket(context.Background(), model.DeleteTicketParams{
+				UserID:   buyer.ID,
+				TicketID: ticket.ID,
+				EventID:  event.ID,
+			})
+			require.NoError(t, err)
+		}
+
+		err = provider.DeleteEvent(context.Background(), event.ID)
+		require.NoError(t, err)
+
+		err = provider.DeleteUser(context.Background(), buyer.ID)
+		require.NoError(t, err)
+
+		err = provider.DeleteUser(context.Background(), host.ID)
+		require.NoError(t, err)
+	}()
+
+	_, err = provider.CancelTicket(context.Background(), model.CancelTicketParams{
+		UserID:   buyer.ID,
+		TicketID: tickets[0].ID,
+	})
+	require.NoError(t, err)
+
+	res, err := provider.ListEventTickets(context.Background(), model.ListEventTicketsParams{
+		EventID: event.ID,
+		Limit:   1,
+		Offset:  0,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Records, 1)
+	require.Equal(t, 1, res.NextOffset)
+	require.Equal(t, tickets[0].ID, res.Records[0].ID)
+	require.Equal(t, buyer.Name, res.Records[0].BuyerName)
+	require.Equal(t, buyer.Email, res.Records[0].BuyerEmail)
+	require.True(t, res.Records[0].Cancelled)
+
+	res, err = provider.ListEventTickets(context.Background(), model.ListEventTicketsParams{
+		EventID: event.ID,
+		Limit:   10,
+		Offset:  1,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Records, 1)
+	require.Equal(t, tickets[1].ID, res.Records[0].ID)
+	require.False(t, res.Records[0].Cancelled)
+
+	stats, err := provider.GetEventSalesStats(context.Background(), event.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), stats.Sold)
+	require.Equal(t, int64(7), stats.Remaining)
+	require.Equal(t, int64(2), stats.Cancelled)
+	require.Len(t, stats.Daily, 1)
+	require.Equal(t, int64(3), stats.Daily[0].Tickets)
+	require.True(t, tickets[1].CreatedAt.Truncate(24*time.Hour).Equal(stats.Daily[0].Day))
+
+	_, err = provider.GetEventSalesStats(context.Background(), 0)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
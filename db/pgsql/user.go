@@ -8,21 +8,79 @@ import (
 
 // CreateUser creates a new user in the database
 func (p *Provider) CreateUser(context context.Context, arg model.CreateUserParams) (*model.User, error) {
+	return p.CreateUserTx(context, model.CreateUserTxParams{CreateUserParams: arg})
+}
+
+// CreateUserTx creates a new user, its first verify email code when
+// arg.SecretCode is set and the outbox messages returned by arg.AfterCreate
+// in one transaction
+func (p *Provider) CreateUserTx(ctx context.Context, arg model.CreateUserTxParams) (*model.User, error) {
+	txProvider, err := p.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			txProvider.tx.Rollback()
+		}
+		txProvider.Close()
+	}()
+
 	user := &model.User{}
-	err := p.conn.QueryRowContext(context, `
+	err = txProvider.tx.QueryRowContext(ctx, `
 		INSERT INTO users (name, email, hashed_password, role)
 		VAlUES ($1, $2, $3, $4)
-		RETURNING id, name, email, hashed_password, role, created_at, password_updated_at
+		RETURNING id, name, email, hashed_password, role, is_email_verified, created_at, password_updated_at
 	`, arg.Name, arg.Email, arg.HashedPassword, model.UserRole_User).Scan(
 		&user.ID,
 		&user.Name,
 		&user.Email,
 		&user.HashedPassword,
 		&user.Role,
+		&user.IsEmailVerified,
 		&user.CreatedAt,
 		&user.PasswordUpdatedAt,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	var verifyEmail *model.VerifyEmail
+	if arg.SecretCode != "" {
+		verifyEmail = &model.VerifyEmail{}
+		err = txProvider.tx.QueryRowContext(ctx, `
+			INSERT INTO verify_emails (user_id, email, secret_code)
+			VALUES ($1, $2, $3)
+			RETURNING id, user_id, email, secret_code, is_used, created_at, expired_at
+		`, user.ID, user.Email, arg.SecretCode).Scan(
+			&verifyEmail.ID,
+			&verifyEmail.UserID,
+			&verifyEmail.Email,
+			&verifyEmail.SecretCode,
+			&verifyEmail.IsUsed,
+			&verifyEmail.CreatedAt,
+			&verifyEmail.ExpiredAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if arg.AfterCreate != nil {
+		var messages []model.CreateOutboxMessageParams
+		messages, err = arg.AfterCreate(user, verifyEmail)
+		if err != nil {
+			return nil, err
+		}
 
+		err = createOutboxMessages(ctx, txProvider.tx, messages)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = txProvider.tx.Commit()
 	if err != nil {
 		return nil, err
 	}
@@ -34,7 +92,7 @@ func (p *Provider) CreateUser(context context.Context, arg model.CreateUserParam
 func (p *Provider) GetUserByEmail(context context.Context, email string) (*model.User, error) {
 	user := &model.User{}
 	err := p.conn.QueryRowContext(context, `
-		SELECT id, name, email, hashed_password, role, created_at, password_updated_at
+		SELECT id, name, email, hashed_password, role, is_email_verified, created_at, password_updated_at
 		FROM users
 		WHERE email = $1
 	`, email).Scan(
@@ -43,6 +101,32 @@ func (p *Provider) GetUserByEmail(context context.Context, email string) (*model
 		&user.Email,
 		&user.HashedPassword,
 		&user.Role,
+		&user.IsEmailVerified,
+		&user.CreatedAt,
+		&user.PasswordUpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// GetUserByID gets a user by id
+func (p *Provider) GetUserByID(context context.Context, id int64) (*model.User, error) {
+	user := &model.User{}
+	err := p.conn.QueryRowContext(context, `
+		SELECT id, name, email, hashed_password, role, is_email_verified, created_at, password_updated_at
+		FROM users
+		WHERE id = $1
+	`, id).Scan(
+		&user.ID,
+		&user.Name,
+		&user.Email,
+		&user.HashedPassword,
+		&user.Role,
+		&user.IsEmailVerified,
 		&user.CreatedAt,
 		&user.PasswordUpdatedAt,
 	)
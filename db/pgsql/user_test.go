@@ -25,6 +25,7 @@ func CreateRandomUser(t *testing.T) *model.User {
 	require.Equal(t, arg.Name, user.Name)
 	require.Equal(t, arg.Email, user.Email)
 	require.Equal(t, model.UserRole_User, user.Role)
+	require.False(t, user.IsEmailVerified)
 	require.NotEmpty(t, user.CreatedAt)
 	require.NotEmpty(t, user.PasswordUpdatedAt)
 
@@ -53,3 +54,18 @@ func TestGetUserByEmail(t *testing.T) {
 	_, err = provider.GetUserByEmail(context.Background(), util.RandomEmail())
 	require.Error(t, err)
 }
+
+func TestGetUserByID(t *testing.T) {
+	user := CreateRandomUser(t)
+	defer func() {
+		err := provider.DeleteUser(context.Background(), user.ID)
+		require.NoError(t, err)
+	}()
+
+	fetchedUser, err := provider.GetUserByID(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Equal(t, user, fetchedUser)
+
+	_, err = provider.GetUserByID(context.Background(), -1)
+	require.Error(t, err)
+}
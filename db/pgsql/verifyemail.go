@@ -0,0 +1,210 @@
+package pgsql
+
+import (
+	"context"
+	"time"
+
+	"github.com/yashagw/event-management-api/db/model"
+)
+
+// CreateVerifyEmail stores a new code to verify the email of a user
+func (p *Provider) CreateVerifyEmail(context context.Context, arg model.CreateVerifyEmailParams) (*model.VerifyEmail, error) {
+	verifyEmail := &model.VerifyEmail{}
+	err := p.conn.QueryRowContext(context, `
+		INSERT INTO verify_emails (user_id, email, secret_code)
+		VALUES ($1, $2, $3)
+		RETURNING id, user_id, email, secret_code, is_used, created_at, expired_at
+	`, arg.UserID, arg.Email, arg.SecretCode).Scan(
+		&verifyEmail.ID,
+		&verifyEmail.UserID,
+		&verifyEmail.Email,
+		&verifyEmail.SecretCode,
+		&verifyEmail.IsUsed,
+		&verifyEmail.CreatedAt,
+		&verifyEmail.ExpiredAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return verifyEmail, nil
+}
+
+// GetVerifyEmail gets a verify email code by id
+func (p *Provider) GetVerifyEmail(context context.Context, id int64) (*model.VerifyEmail, error) {
+	verifyEmail := &model.VerifyEmail{}
+	err := p.conn.QueryRowContext(context, `
+		SELECT id, user_id, email, secret_code, is_used, created_at, expired_at
+		FROM verify_emails
+		WHERE id = $1
+	`, id).Scan(
+		&verifyEmail.ID,
+		&verifyEmail.UserID,
+		&verifyEmail.Email,
+		&verifyEmail.SecretCode,
+		&verifyEmail.IsUsed,
+		&verifyEmail.CreatedAt,
+		&verifyEmail.ExpiredAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return verifyEmail, nil
+}
+
+// ResendVerifyEmailTx creates a new code for the current email of the user and
+// the outbox messages returned by arg.AfterCreate in one transaction.
+// It returns model.ErrEmailAlreadyVerified when there is nothing to verify and
+// model.ErrVerifyEmailTooSoon when the previous code is younger than arg.Interval.
+func (p *Provider) ResendVerifyEmailTx(ctx context.Context, arg model.ResendVerifyEmailTxParams) (*model.VerifyEmail, error) {
+	txProvider, err := p.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			txProvider.tx.Rollback()
+		}
+		txProvider.Close()
+	}()
+
+	// Locking the user makes concurrent requests wait for each other, so only
+	// one of them gets past the interval check
+	var email string
+	var isEmailVerified bool
+	err = txProvider.tx.QueryRowContext(ctx, `
+		SELECT email, is_email_verified FROM users WHERE id = $1 FOR UPDATE
+	`, arg.UserID).Scan(&email, &isEmailVerified)
+	if err != nil {
+		return nil, err
+	}
+
+	if isEmailVerified {
+		err = model.ErrEmailAlreadyVerified
+		return nil, err
+	}
+
+	var sentRecently bool
+	err = txProvider.tx.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM verify_emails
+			WHERE user_id = $1 AND created_at > now() - make_interval(secs => $2)
+		)
+	`, arg.UserID, arg.Interval.Seconds()).Scan(&sentRecently)
+	if err != nil {
+		return nil, err
+	}
+
+	if sentRecently {
+		err = model.ErrVerifyEmailTooSoon
+		return nil, err
+	}
+
+	verifyEmail := &model.VerifyEmail{}
+	err = txProvider.tx.QueryRowContext(ctx, `
+		INSERT INTO verify_emails (user_id, email, secret_code)
+		VALUES ($1, $2, $3)
+		RETURNING id, user_id, email, secret_code, is_used, created_at, expired_at
+	`, arg.UserID, email, arg.SecretCode).Scan(
+		&verifyEmail.ID,
+		&verifyEmail.UserID,
+		&verifyEmail.Email,
+		&verifyEmail.SecretCode,
+		&verifyEmail.IsUsed,
+		&verifyEmail.CreatedAt,
+		&verifyEmail.ExpiredAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if arg.AfterCreate != nil {
+		var messages []model.CreateOutboxMessageParams
+		messages, err = arg.AfterCreate(verifyEmail)
+		if err != nil {
+			return nil, err
+		}
+
+		err = createOutboxMessages(ctx, txProvider.tx, messages)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = txProvider.tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return verifyEmail, nil
+}
+
+// VerifyEmail marks the code as used and the email of its user as verified.
+// It returns sql.ErrNoRows when the code does not exist, was already used or has expired.
+func (p *Provider) VerifyEmail(ctx context.Context, arg model.VerifyEmailParams) (*model.User, error) {
+	txProvider, err := p.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			txProvider.tx.Rollback()
+		}
+		txProvider.Close()
+	}()
+
+	var userID int64
+	var email string
+	err = txProvider.tx.QueryRowContext(ctx, `
+		UPDATE verify_emails SET is_used = true
+		WHERE id = $1 AND secret_code = $2 AND is_used = false AND expired_at > now()
+		RETURNING user_id, email
+	`, arg.EmailID, arg.SecretCode).Scan(&userID, &email)
+	if err != nil {
+		return nil, err
+	}
+
+	// The email may have changed since the code was sent
+	user := &model.User{}
+	err = txProvider.tx.QueryRowContext(ctx, `
+		UPDATE users SET is_email_verified = true
+		WHERE id = $1 AND email = $2
+		RETURNING id, name, email, hashed_password, role, is_email_verified, created_at, password_updated_at
+	`, userID, email).Scan(
+		&user.ID,
+		&user.Name,
+		&user.Email,
+		&user.HashedPassword,
+		&user.Role,
+		&user.IsEmailVerified,
+		&user.CreatedAt,
+		&user.PasswordUpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = txProvider.tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// DeleteExpiredVerifyEmails deletes codes that expired before the given time
+func (p *Provider) DeleteExpiredVerifyEmails(context context.Context, before time.Time) (int64, error) {
+	result, err := p.conn.ExecContext(context, `
+		DELETE FROM verify_emails WHERE expired_at < $1
+	`, before)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
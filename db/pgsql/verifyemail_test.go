@@ -0,0 +1,172 @@
+package pgsql
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/util"
+)
+
+func CreateRandomVerifyEmail(t *testing.T, user *model.User) *model.VerifyEmail {
+	arg := model.CreateVerifyEmailParams{
+		UserID:     user.ID,
+		Email:      user.Email,
+		SecretCode: util.RandomString(32),
+	}
+
+	verifyEmail, err := provider.CreateVerifyEmail(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, verifyEmail.ID)
+	require.Equal(t, arg.UserID, verifyEmail.UserID)
+	require.Equal(t, arg.Email, verifyEmail.Email)
+	require.Equal(t, arg.SecretCode, verifyEmail.SecretCode)
+	require.False(t, verifyEmail.IsUsed)
+	require.True(t, verifyEmail.ExpiredAt.After(verifyEmail.CreatedAt))
+
+	return verifyEmail
+}
+
+func TestVerifyEmail(t *testing.T) {
+	user := CreateRandomUser(t)
+	defer func() {
+		err := provider.DeleteUser(context.Background(), user.ID)
+		require.NoError(t, err)
+	}()
+
+	verifyEmail := CreateRandomVerifyEmail(t, user)
+
+	// A wrong code does not verify the email
+	_, err := provider.VerifyEmail(context.Background(), model.VerifyEmailParams{
+		EmailID:    verifyEmail.ID,
+		SecretCode: util.RandomString(32),
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	verifiedUser, err := provider.VerifyEmail(context.Background(), model.VerifyEmailParams{
+		EmailID:    verifyEmail.ID,
+		SecretCode: verifyEmail.SecretCode,
+	})
+	require.NoError(t, err)
+	require.Equal(t, user.ID, verifiedUser.ID)
+	require.True(t, verifiedUser.IsEmailVerified)
+
+	// A code can only be used once
+	_, err = provider.VerifyEmail(context.Background(), model.VerifyEmailParams{
+		EmailID:    verifyEmail.ID,
+		SecretCode: verifyEmail.SecretCode,
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestDeleteExpiredVerifyEmails(t *testing.T) {
+	user := CreateRandomUser(t)
+	defer func() {
+		err := provider.DeleteUser(context.Background(), user.ID)
+		require.NoError(t, err)
+	}()
+
+	verifyEmail := CreateRandomVerifyEmail(t, user)
+
+	deleted, err := provider.DeleteExpiredVerifyEmails(context.Background(), verifyEmail.ExpiredAt.Add(time.Second))
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, deleted, int64(1))
+
+	// A deleted code cannot be used anymore
+	_, err = provider.VerifyEmail(context.Background(), model.VerifyEmailParams{
+		EmailID:    verifyEmail.ID,
+		SecretCode: verifyEmail.SecretCode,
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestResendVerifyEmailTx(t *testing.T) {
+	user := CreateRandomUser(t)
+	defer func() {
+		err := provider.DeleteUser(context.Background(), user.ID)
+		require.NoError(t, err)
+	}()
+
+	taskID := util.RandomString(16)
+	arg := model.ResendVerifyEmailTxParams{
+		UserID:     user.ID,
+		SecretCode: util.RandomString(32),
+		Interval:   time.Minute,
+		AfterCreate: func(verifyEmail *model.VerifyEmail) ([]model.CreateOutboxMessageParams, error) {
+			return []model.CreateOutboxMessageParams{{
+				TaskID:   taskID,
+				TaskType: "task:test",
+				Payload:  []byte(`{}`),
+				Queue:    "default",
+				MaxRetry: 3,
+			}}, nil
+		},
+	}
+
+	verifyEmail, err := provider.ResendVerifyEmailTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, user.ID, verifyEmail.UserID)
+	require.Equal(t, user.Email, verifyEmail.Email)
+	require.Equal(t, arg.SecretCode, verifyEmail.SecretCode)
+
+	gotVerifyEmail, err := provider.GetVerifyEmail(context.Background(), verifyEmail.ID)
+	require.NoError(t, err)
+	require.Equal(t, verifyEmail.SecretCode, gotVerifyEmail.SecretCode)
+
+	message := dispatchTask(t, taskID, nil, 0)
+	require.NotNil(t, message)
+
+	// Another code within the interval is refused
+	arg.SecretCode = util.RandomString(32)
+	_, err = provider.ResendVerifyEmailTx(context.Background(), arg)
+	require.ErrorIs(t, err, model.ErrVerifyEmailTooSoon)
+
+	_, err = provider.VerifyEmail(context.Background(), model.VerifyEmailParams{
+		EmailID:    verifyEmail.ID,
+		SecretCode: verifyEmail.SecretCode,
+	})
+	require.NoError(t, err)
+
+	// Once verified there is nothing left to send
+	arg.Interval = 0
+	_, err = provider.ResendVerifyEmailTx(context.Background(), arg)
+	require.ErrorIs(t, err, model.ErrEmailAlreadyVerified)
+}
+
+func TestCreateUserTxCreatesVerifyEmail(t *testing.T) {
+	hashedPassword, err := util.HashPassword(util.RandomString(6))
+	require.NoError(t, err)
+
+	var created *model.VerifyEmail
+	arg := model.CreateUserTxParams{
+		CreateUserParams: model.CreateUserParams{
+			Name:           util.RandomName(),
+			Email:          util.RandomEmail(),
+			HashedPassword: hashedPassword,
+		},
+		SecretCode: util.RandomString(32),
+		AfterCreate: func(user *model.User, verifyEmail *model.VerifyEmail) ([]model.CreateOutboxMessageParams, error) {
+			created = verifyEmail
+			return nil, nil
+		},
+	}
+	user, err := provider.CreateUserTx(context.Background(), arg)
+	require.NoError(t, err)
+	defer func() {
+		err := provider.DeleteUser(context.Background(), user.ID)
+		require.NoError(t, err)
+	}()
+
+	require.NotNil(t, created)
+	require.Equal(t, user.ID, created.UserID)
+	require.Equal(t, user.Email, created.Email)
+	require.Equal(t, arg.SecretCode, created.SecretCode)
+
+	verifyEmail, err := provider.GetVerifyEmail(context.Background(), created.ID)
+	require.NoError(t, err)
+	require.Equal(t, arg.SecretCode, verifyEmail.SecretCode)
+	require.False(t, verifyEmail.IsUsed)
+}
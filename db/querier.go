@@ -2,38 +2,97 @@ package db
 
 import (
 	"context"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/yashagw/event-management-api/db/model"
 )
 
 type UserQuerier interface {
 	// CreateUser creates a new user in the database
 	CreateUser(context context.Context, arg model.CreateUserParams) (*model.User, error)
+	// CreateUserTx creates a new user, its first verify email code and its outbox messages in one transaction
+	CreateUserTx(context context.Context, arg model.CreateUserTxParams) (*model.User, error)
 	GetUserByEmail(context context.Context, email string) (*model.User, error)
+	GetUserByID(context context.Context, id int64) (*model.User, error)
 	DeleteUser(context context.Context, id int64) error
 
+	CreateVerifyEmail(context context.Context, arg model.CreateVerifyEmailParams) (*model.VerifyEmail, error)
+	GetVerifyEmail(context context.Context, id int64) (*model.VerifyEmail, error)
+	// ResendVerifyEmailTx creates a new code for the user and its outbox messages in one transaction
+	ResendVerifyEmailTx(context context.Context, arg model.ResendVerifyEmailTxParams) (*model.VerifyEmail, error)
+	VerifyEmail(context context.Context, arg model.VerifyEmailParams) (*model.User, error)
+	DeleteExpiredVerifyEmails(context context.Context, before time.Time) (int64, error)
+
 	CreateRequestToBecomeHost(context context.Context, userID int64) (*model.UserHostRequest, error)
 	GetRequestToBecomeHost(context context.Context, userID int64) (*model.UserHostRequest, error)
 	DeleteRequestToBecomeHost(context context.Context, id int64) error
 	ListPendingRequests(context context.Context, request model.ListPendingRequestsParams) (*model.ListPendingRequestsResponse, error)
-	ApproveDisapproveRequestToBecomeHost(context context.Context, request model.ApproveDisapproveRequestToBecomeHostParams) error
+	ApproveDisapproveRequestToBecomeHost(context context.Context, request model.ApproveDisapproveRequestToBecomeHostParams) (*model.UserHostRequest, error)
+	ApproveDisapproveRequestToBecomeHostTx(context context.Context, request model.ApproveDisapproveRequestToBecomeHostTxParams) (*model.UserHostRequest, error)
+	ExpireHostRequests(context context.Context, arg model.ExpireHostRequestsParams) (int64, error)
 }
 
 type EventQuerier interface {
 	CreateEvent(context context.Context, request model.CreateEventParams) (*model.Event, error)
 	GetEvent(context context.Context, request model.GetEventParams) (*model.Event, error)
 	ListEvents(context context.Context, request model.ListEventsParams) (*model.ListEventsResponse, error)
+	// SearchEvents lists the events matching request.Query, best matches first
+	SearchEvents(context context.Context, request model.SearchEventsParams) (*model.SearchEventsResponse, error)
+	UpdateEvent(context context.Context, request model.UpdateEventParams) (*model.Event, error)
+	// UpdateEventTx updates an event of the host and writes its outbox messages in one transaction
+	UpdateEventTx(context context.Context, request model.UpdateEventTxParams) (*model.Event, error)
+	// UpdateEventStatus publishes or unpublishes an event of the host
+	UpdateEventStatus(context context.Context, request model.UpdateEventStatusParams) (*model.Event, error)
+	CancelEvent(context context.Context, request model.CancelEventParams) (*model.Event, error)
+	// CancelEventTx cancels an event of the host, refunds its tickets and writes its outbox messages in one transaction
+	CancelEventTx(context context.Context, request model.CancelEventTxParams) (*model.Event, error)
 	DeleteEvent(context context.Context, id int64) error
+	// CompleteEvents marks events that ended before endedBefore as completed and gives published ones the completed status
+	CompleteEvents(context context.Context, endedBefore time.Time) (int64, error)
+	// QueueEventReminders writes reminders for upcoming events to the outbox and returns how many events were reminded
+	QueueEventReminders(context context.Context, arg model.QueueEventRemindersParams) (int64, error)
 }
 
 type TicketQuerier interface {
 	CreateTicket(context context.Context, request model.CreateTicketParams) (*model.Ticket, error)
+	CreateTicketTx(context context.Context, request model.CreateTicketTxParams) (*model.Ticket, error)
 	GetTicket(context context.Context, request model.GetTicketParams) (*model.Ticket, error)
+	// ListTickets lists the tickets of a user joined with their events
+	ListTickets(context context.Context, request model.ListTicketsParams) (*model.ListTicketsResponse, error)
+	// ListEventTickets lists the tickets sold for an event joined with their buyers
+	ListEventTickets(context context.Context, request model.ListEventTicketsParams) (*model.ListEventTicketsResponse, error)
+	GetEventSalesStats(context context.Context, eventID int64) (*model.EventSalesStats, error)
+	CancelTicket(context context.Context, request model.CancelTicketParams) (*model.Refund, error)
+	CancelTicketTx(context context.Context, request model.CancelTicketTxParams) (*model.Refund, error)
 	DeleteTicket(context context.Context, request model.DeleteTicketParams) error
 }
 
+type SessionQuerier interface {
+	CreateSession(context context.Context, arg model.CreateSessionParams) (*model.Session, error)
+	GetSession(context context.Context, id uuid.UUID) (*model.Session, error)
+	UpdateSessionAccessToken(context context.Context, arg model.UpdateSessionAccessTokenParams) error
+	BlockSession(context context.Context, arg model.BlockSessionParams) (*model.Session, error)
+	BlockUserSessions(context context.Context, userID int64) ([]model.Session, error)
+
+	RevokeToken(context context.Context, arg model.RevokeTokenParams) error
+	IsTokenRevoked(context context.Context, id uuid.UUID) (bool, error)
+
+	DeleteExpiredSessions(context context.Context, before time.Time) (int64, error)
+	DeleteExpiredRevokedTokens(context context.Context, before time.Time) (int64, error)
+}
+
+type OutboxQuerier interface {
+	// DispatchOutboxMessages hands a batch of pending messages to arg.Dispatch and
+	// returns how many of them were dispatched
+	DispatchOutboxMessages(context context.Context, arg model.DispatchOutboxMessagesParams) (int, error)
+	DeleteDispatchedOutboxMessages(context context.Context, before time.Time) (int64, error)
+}
+
 type DBQuerier interface {
 	UserQuerier
 	EventQuerier
 	TicketQuerier
+	SessionQuerier
+	OutboxQuerier
 }
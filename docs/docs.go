@@ -19,149 +19,118 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/hosts/events": {
+        "/admin/queues/{queue}/tasks": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Lists events created by the host.",
+                "description": "Lists the tasks of a queue that wait for a retry or ran out of retries and were archived.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "host"
+                    "admin"
                 ],
-                "summary": "Lists events created by the host.",
+                "summary": "Lists failed tasks of a queue.",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Limit",
-                        "name": "limit",
+                        "type": "string",
+                        "description": "Queue",
+                        "name": "queue",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "retry",
+                            "archived"
+                        ],
+                        "type": "string",
+                        "description": "State",
+                        "name": "state",
                         "in": "query",
                         "required": true
                     },
                     {
                         "type": "integer",
-                        "description": "Offset",
-                        "name": "offset",
+                        "description": "Page, starts at 1",
+                        "name": "page",
                         "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.ListEventsResponse"
+                            "$ref": "#/definitions/api.ListFailedTasksResponse"
                         }
-                    }
-                }
-            },
-            "post": {
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ],
-                "description": "Creates a new event.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "host"
-                ],
-                "summary": "Creates a new event.",
-                "parameters": [
-                    {
-                        "description": "Event",
-                        "name": "event",
-                        "in": "body",
-                        "required": true,
+                    },
+                    "401": {
+                        "description": "Not Authorized",
                         "schema": {
-                            "$ref": "#/definitions/api.CreateEventParams"
+                            "$ref": "#/definitions/api.ResponseMessage"
                         }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Created",
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/model.Event"
+                            "$ref": "#/definitions/api.ResponseMessage"
                         }
-                    }
-                }
-            }
-        },
-        "/hosts/events/{event_id}": {
-            "get": {
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ],
-                "description": "Get event info created by the host.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "host"
-                ],
-                "summary": "Get event info created by the host.",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Event ID",
-                        "name": "event_id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "404": {
+                        "description": "Queue not found",
                         "schema": {
-                            "$ref": "#/definitions/model.Event"
+                            "$ref": "#/definitions/api.ResponseMessage"
                         }
                     }
                 }
             }
         },
-        "/moderators/requests": {
+        "/admin/queues/{queue}/tasks/{task_id}": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Lists pending requests to become host.",
+                "description": "Gets a task with its payload and last error.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "moderator"
+                    "admin"
                 ],
-                "summary": "Lists pending requests to become host.",
+                "summary": "Gets a task.",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Limit",
-                        "name": "limit",
-                        "in": "query",
+                        "type": "string",
+                        "description": "Queue",
+                        "name": "queue",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Offset",
-                        "name": "offset",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "task_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.ListPendingRequestsResponse"
+                            "$ref": "#/definitions/api.TaskResponse"
                         }
                     },
                     "401": {
@@ -169,37 +138,54 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/api.ResponseMessage"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
                     }
                 }
             },
-            "post": {
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Approves or disapproves a request to become host.",
+                "description": "Deletes a task that waits for a retry or was archived.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "moderator"
+                    "admin"
                 ],
-                "summary": "Approves or disapproves a request to become host.",
+                "summary": "Deletes a failed task.",
                 "parameters": [
                     {
-                        "description": "Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/api.ApproveDisapproveUserHostRequestParams"
-                        }
+                        "type": "string",
+                        "description": "Queue",
+                        "name": "queue",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "task_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "request approved/disapproved",
+                        "description": "task deleted",
                         "schema": {
                             "$ref": "#/definitions/api.ResponseMessage"
                         }
@@ -209,59 +195,62 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/api.ResponseMessage"
                         }
-                    }
-                }
-            }
-        },
-        "/users": {
-            "post": {
-                "description": "Creates a new user.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "user"
-                ],
-                "summary": "Creates a new user.",
-                "parameters": [
-                    {
-                        "description": "User",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/api.CreateUserParams"
+                            "$ref": "#/definitions/api.ResponseMessage"
                         }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Created",
+                    },
+                    "404": {
+                        "description": "Task not found",
                         "schema": {
-                            "$ref": "#/definitions/api.UserResponse"
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "409": {
+                        "description": "Task has not failed",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
                         }
                     }
                 }
             }
         },
-        "/users/host": {
+        "/admin/queues/{queue}/tasks/{task_id}/retry": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Creates a new request to become host.",
+                "description": "Runs a task that waits for a retry or was archived again right away.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "admin"
+                ],
+                "summary": "Retries a failed task.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Queue",
+                        "name": "queue",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "task_id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Creates a new request to become host.",
                 "responses": {
                     "200": {
-                        "description": "request to become host created",
+                        "description": "task queued",
                         "schema": {
                             "$ref": "#/definitions/api.ResponseMessage"
                         }
@@ -271,64 +260,277 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/api.ResponseMessage"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "409": {
+                        "description": "Task has not failed",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
                     }
                 }
             }
         },
-        "/users/login": {
-            "post": {
-                "description": "Logs in a user.",
+        "/events": {
+            "get": {
+                "description": "Lists all published, ongoing, completed and cancelled events, drafts are hidden.",
                 "produces": [
                     "application/json"
                 ],
-                "tags": [
-                    "user"
-                ],
-                "summary": "Logs in a user.",
+                "summary": "Lists all events.",
                 "parameters": [
                     {
-                        "description": "User",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/api.LoginUserParams"
-                        }
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ongoing",
+                            "upcoming",
+                            "past"
+                        ],
+                        "type": "string",
+                        "description": "Period",
+                        "name": "period",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of calendar days the event spans",
+                        "name": "duration_days",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month the event takes place in, as YYYY-MM",
+                        "name": "month",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "City",
+                        "name": "city",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minimum tickets left",
+                        "name": "min_tickets_left",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.LoginUserResponse"
+                            "$ref": "#/definitions/model.ListEventsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filters",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
                         }
                     }
                 }
             }
         },
-        "/users/ticket": {
-            "post": {
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ],
-                "description": "Buys ticket for an event.",
+        "/events/search": {
+            "get": {
+                "description": "Searches the name, location and description of the events that are not drafts, best matches first. Every word has to match, as a whole word or the start of one. Matches are wrapped in \u003cb\u003e\u003c/b\u003e in the headline.",
                 "produces": [
                     "application/json"
                 ],
-                "tags": [
-                    "user"
-                ],
-                "summary": "Buys ticket for an event.",
+                "summary": "Searches events.",
                 "parameters": [
                     {
-                        "description": "Ticket",
-                        "name": "ticket",
-                        "in": "body",
-                        "required": true,
+                        "type": "string",
+                        "description": "Search text",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ongoing",
+                            "upcoming",
+                            "past"
+                        ],
+                        "type": "string",
+                        "description": "Period",
+                        "name": "period",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of calendar days the event spans",
+                        "name": "duration_days",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month the event takes place in, as YYYY-MM",
+                        "name": "month",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "City",
+                        "name": "city",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minimum tickets left",
+                        "name": "min_tickets_left",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.CreateTicketParams"
+                            "$ref": "#/definitions/model.SearchEventsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid search",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    }
+                }
+            }
+        },
+        "/hosts/events": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Lists events created by the host, drafts included.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "host"
+                ],
+                "summary": "Lists events created by the host.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ongoing",
+                            "upcoming",
+                            "past"
+                        ],
+                        "type": "string",
+                        "description": "Period",
+                        "name": "period",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of calendar days the event spans",
+                        "name": "duration_days",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month the event takes place in, as YYYY-MM",
+                        "name": "month",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "City",
+                        "name": "city",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minimum tickets left",
+                        "name": "min_tickets_left",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ListEventsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Creates a new event.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "host"
+                ],
+                "summary": "Creates a new event.",
+                "parameters": [
+                    {
+                        "description": "Event",
+                        "name": "event",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.CreateEventParams"
                         }
                     }
                 ],
@@ -336,137 +538,1386 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/model.Ticket"
+                            "$ref": "#/definitions/model.Event"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid event",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    }
+                }
+            }
+        },
+        "/hosts/events/search": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Searches the name, location and description of the events of the host, drafts included, best matches first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "host"
+                ],
+                "summary": "Searches events created by the host.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search text",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ongoing",
+                            "upcoming",
+                            "past"
+                        ],
+                        "type": "string",
+                        "description": "Period",
+                        "name": "period",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of calendar days the event spans",
+                        "name": "duration_days",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month the event takes place in, as YYYY-MM",
+                        "name": "month",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "City",
+                        "name": "city",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minimum tickets left",
+                        "name": "min_tickets_left",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.SearchEventsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid search",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "401": {
+                        "description": "Not Authorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    }
+                }
+            }
+        },
+        "/hosts/events/{event_id}": {
+            "get": {
+                "description": "Get event info, drafts are not found",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get event info",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Event"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Updates an event of the host. The description can be changed at any time, the other fields only while no tickets are sold. Ticket holders are told about the change by email.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "host"
+                ],
+                "summary": "Updates an event.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Changes",
+                        "name": "event",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.UpdateEventParams"
                         }
                     }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Event"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid changes",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "401": {
+                        "description": "Not Authorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "403": {
+                        "description": "Event of another host",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "404": {
+                        "description": "Event not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "409": {
+                        "description": "Tickets sold, event cancelled or ended",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    }
+                }
+            }
+        },
+        "/hosts/events/{event_id}/cancel": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Cancels an event of the host and refunds all of its tickets. Ticket holders are told by email, the event stays readable with the cancelled status.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "host"
+                ],
+                "summary": "Cancels an event.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Event"
+                        }
+                    },
+                    "401": {
+                        "description": "Not Authorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "403": {
+                        "description": "Event of another host",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "404": {
+                        "description": "Event not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "409": {
+                        "description": "Event already cancelled or ended",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    }
+                }
+            }
+        },
+        "/hosts/events/{event_id}/publish": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Publishes a draft event of the host so that it is listed and tickets can be bought.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "host"
+                ],
+                "summary": "Publishes an event.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Event"
+                        }
+                    },
+                    "401": {
+                        "description": "Not Authorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "403": {
+                        "description": "Event of another host",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "404": {
+                        "description": "Event not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "409": {
+                        "description": "Event is not a draft",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    }
+                }
+            }
+        },
+        "/hosts/events/{event_id}/tickets": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Lists the tickets sold for an event of the host with their buyers, together with the sales of the event.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "host"
+                ],
+                "summary": "Lists tickets sold for an event.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.ListEventTicketsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not Authorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "403": {
+                        "description": "Event of another host",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "404": {
+                        "description": "Event not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    }
+                }
+            }
+        },
+        "/hosts/events/{event_id}/unpublish": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Turns a published event of the host that has not started and has no tickets sold back into a draft.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "host"
+                ],
+                "summary": "Unpublishes an event.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Event"
+                        }
+                    },
+                    "401": {
+                        "description": "Not Authorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "403": {
+                        "description": "Event of another host",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "404": {
+                        "description": "Event not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "409": {
+                        "description": "Event is not published or tickets sold",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    }
+                }
+            }
+        },
+        "/moderators/requests": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Lists pending requests to become host.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "moderator"
+                ],
+                "summary": "Lists pending requests to become host.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ListPendingRequestsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not Authorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Approves or disapproves a request to become host.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "moderator"
+                ],
+                "summary": "Approves or disapproves a request to become host.",
+                "parameters": [
+                    {
+                        "description": "Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.ApproveDisapproveUserHostRequestParams"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "request approved/disapproved",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "401": {
+                        "description": "Not Authorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    }
+                }
+            }
+        },
+        "/tokens/renew_access": {
+            "post": {
+                "description": "Swaps a valid refresh token for a new access token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Renews an access token.",
+                "parameters": [
+                    {
+                        "description": "Refresh token",
+                        "name": "token",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.RenewAccessTokenParams"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.RenewAccessTokenResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not Authorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "post": {
+                "description": "Creates a new user.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Creates a new user.",
+                "parameters": [
+                    {
+                        "description": "User",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.CreateUserParams"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/api.UserResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/host": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Creates a new request to become host.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Creates a new request to become host.",
+                "responses": {
+                    "200": {
+                        "description": "request to become host created",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "401": {
+                        "description": "Not Authorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/login": {
+            "post": {
+                "description": "Logs in a user.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Logs in a user.",
+                "parameters": [
+                    {
+                        "description": "User",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.LoginUserParams"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.LoginUserResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/logout": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Blocks the session so its refresh token can no longer be used and revokes its access tokens.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Logs out a session.",
+                "parameters": [
+                    {
+                        "description": "Session to log out",
+                        "name": "session",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.LogoutUserParams"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "logged out",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "401": {
+                        "description": "Not Authorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "404": {
+                        "description": "Session not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/logout_all": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Blocks every session of the user and revokes their access tokens.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Logs out every session of the user.",
+                "responses": {
+                    "200": {
+                        "description": "logged out of all sessions",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "401": {
+                        "description": "Not Authorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/resend_verify_email": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Sends a new code to verify the email of the user. A new code can be asked for once every VERIFY_EMAIL_INTERVAL.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Sends a new verification email.",
+                "responses": {
+                    "200": {
+                        "description": "verification email sent",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "401": {
+                        "description": "Not Authorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "409": {
+                        "description": "Email already verified",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "429": {
+                        "description": "Asked for too soon",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/ticket": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Buys ticket for an event.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Buys ticket for an event.",
+                "parameters": [
+                    {
+                        "description": "Ticket",
+                        "name": "ticket",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.CreateTicketParams"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.Ticket"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid event or quantity",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "404": {
+                        "description": "Event not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "409": {
+                        "description": "Event not on sale or not enough tickets left",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/tickets": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Lists the tickets of the user with their events, the latest purchase first unless sorted by event start.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Lists bought tickets.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Event name or location",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "upcoming",
+                            "past"
+                        ],
+                        "type": "string",
+                        "description": "Period",
+                        "name": "period",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "purchase_date",
+                            "event_start"
+                        ],
+                        "type": "string",
+                        "description": "Sort by",
+                        "name": "sort_by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ListTicketsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not Authorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/tickets/{ticket_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Cancels tickets before the cancellation cutoff of their event, the tickets go back on sale.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Cancels tickets and refunds them.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Ticket ID",
+                        "name": "ticket_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Refund"
+                        }
+                    },
+                    "401": {
+                        "description": "Not Authorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "404": {
+                        "description": "Ticket not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    },
+                    "409": {
+                        "description": "Ticket already cancelled or cancellation closed",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/verify_email": {
+            "get": {
+                "description": "Checks the code sent by email and marks the email of the user as verified.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Verifies the email of a user.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Email ID",
+                        "name": "email_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Secret code",
+                        "name": "secret_code",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.VerifyEmailResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid or expired code",
+                        "schema": {
+                            "$ref": "#/definitions/api.ResponseMessage"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "api.ApproveDisapproveUserHostRequestParams": {
+            "type": "object",
+            "properties": {
+                "approved": {
+                    "type": "boolean"
+                },
+                "request_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.CreateEventParams": {
+            "type": "object",
+            "properties": {
+                "cancellation_cutoff_hours": {
+                    "description": "Tickets can be cancelled until this many hours before the start",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "description": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "total_tickets": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api.CreateTicketParams": {
+            "type": "object",
+            "required": [
+                "event_id",
+                "quantity"
+            ],
+            "properties": {
+                "event_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "quantity": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "api.CreateUserParams": {
+            "type": "object",
+            "required": [
+                "email",
+                "name",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 8
+                }
+            }
+        },
+        "api.ListEventTicketsResponse": {
+            "type": "object",
+            "properties": {
+                "next_offset": {
+                    "type": "integer"
+                },
+                "records": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.EventTicket"
+                    }
+                },
+                "stats": {
+                    "$ref": "#/definitions/model.EventSalesStats"
+                }
+            }
+        },
+        "api.ListFailedTasksResponse": {
+            "type": "object",
+            "properties": {
+                "records": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.TaskResponse"
+                    }
+                }
+            }
+        },
+        "api.LoginUserParams": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 8
+                }
+            }
+        },
+        "api.LoginUserResponse": {
+            "type": "object",
+            "properties": {
+                "refresh_token": {
+                    "type": "string"
+                },
+                "refresh_token_expires_at": {
+                    "type": "string"
+                },
+                "session_id": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                },
+                "token_expires_at": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/api.UserResponse"
+                }
+            }
+        },
+        "api.LogoutUserParams": {
+            "type": "object",
+            "required": [
+                "session_id"
+            ],
+            "properties": {
+                "session_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.RenewAccessTokenParams": {
+            "type": "object",
+            "required": [
+                "refresh_token"
+            ],
+            "properties": {
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.RenewAccessTokenResponse": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string"
+                },
+                "token_expires_at": {
+                    "type": "string"
                 }
             }
-        }
-    },
-    "definitions": {
-        "api.ApproveDisapproveUserHostRequestParams": {
+        },
+        "api.ResponseMessage": {
             "type": "object",
             "properties": {
-                "approved": {
-                    "type": "boolean"
-                },
-                "request_id": {
-                    "type": "integer"
+                "message": {
+                    "type": "string"
                 }
             }
         },
-        "api.CreateEventParams": {
+        "api.TaskResponse": {
             "type": "object",
             "properties": {
-                "description": {
+                "id": {
                     "type": "string"
                 },
-                "end_date": {
+                "last_error": {
                     "type": "string"
                 },
-                "location": {
+                "last_failed_at": {
                     "type": "string"
                 },
-                "name": {
+                "max_retry": {
+                    "type": "integer"
+                },
+                "next_process_at": {
                     "type": "string"
                 },
-                "start_date": {
+                "payload": {
+                    "type": "object"
+                },
+                "queue": {
                     "type": "string"
                 },
-                "total_tickets": {
-                    "type": "integer"
-                }
-            }
-        },
-        "api.CreateTicketParams": {
-            "type": "object",
-            "properties": {
-                "event_id": {
+                "retried": {
                     "type": "integer"
                 },
-                "quantity": {
-                    "type": "integer"
+                "state": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
                 }
             }
         },
-        "api.CreateUserParams": {
+        "api.UpdateEventParams": {
             "type": "object",
-            "required": [
-                "email",
-                "name",
-                "password"
-            ],
             "properties": {
-                "email": {
+                "description": {
                     "type": "string"
                 },
-                "name": {
+                "end_date": {
                     "type": "string"
                 },
-                "password": {
+                "location": {
                     "type": "string",
-                    "minLength": 8
+                    "minLength": 1
+                },
+                "name": {
+                    "type": "string",
+                    "minLength": 1
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "total_tickets": {
+                    "type": "integer",
+                    "minimum": 1
                 }
             }
         },
-        "api.LoginUserParams": {
+        "api.UserResponse": {
             "type": "object",
-            "required": [
-                "email",
-                "password"
-            ],
             "properties": {
+                "created_at": {
+                    "type": "string"
+                },
                 "email": {
                     "type": "string"
                 },
-                "password": {
-                    "type": "string",
-                    "minLength": 8
+                "id": {
+                    "type": "integer"
+                },
+                "is_email_verified": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "password_updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "api.LoginUserResponse": {
+        "api.VerifyEmailResponse": {
             "type": "object",
             "properties": {
-                "token": {
-                    "type": "string"
-                },
-                "user": {
-                    "$ref": "#/definitions/api.UserResponse"
+                "is_verified": {
+                    "type": "boolean"
                 }
             }
         },
-        "api.ResponseMessage": {
+        "model.DailySales": {
             "type": "object",
             "properties": {
-                "message": {
+                "day": {
                     "type": "string"
+                },
+                "tickets": {
+                    "type": "integer"
                 }
             }
         },
-        "api.UserResponse": {
+        "model.Event": {
             "type": "object",
             "properties": {
+                "cancellation_cutoff_hours": {
+                    "description": "Tickets can be cancelled until this many hours before StartDate",
+                    "type": "integer"
+                },
                 "created_at": {
                     "type": "string"
                 },
-                "email": {
+                "description": {
+                    "type": "string"
+                },
+                "end_date": {
                     "type": "string"
                 },
+                "host_id": {
+                    "type": "integer"
+                },
                 "id": {
                     "type": "integer"
                 },
+                "left_tickets": {
+                    "type": "integer"
+                },
+                "location": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 },
-                "password_updated_at": {
+                "start_date": {
                     "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/model.EventStatus"
+                },
+                "total_tickets": {
+                    "type": "integer"
                 }
             }
         },
-        "model.Event": {
+        "model.EventSalesStats": {
+            "type": "object",
+            "properties": {
+                "cancelled": {
+                    "description": "Cancelled is the number of tickets that were cancelled",
+                    "type": "integer"
+                },
+                "daily": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.DailySales"
+                    }
+                },
+                "remaining": {
+                    "type": "integer"
+                },
+                "sold": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.EventSearchResult": {
             "type": "object",
             "properties": {
+                "cancellation_cutoff_hours": {
+                    "description": "Tickets can be cancelled until this many hours before StartDate",
+                    "type": "integer"
+                },
                 "created_at": {
                     "type": "string"
                 },
@@ -476,6 +1927,10 @@ const docTemplate = `{
                 "end_date": {
                     "type": "string"
                 },
+                "headline": {
+                    "description": "Headline is a snippet of the event with the matches wrapped in \u003cb\u003e\u003c/b\u003e",
+                    "type": "string"
+                },
                 "host_id": {
                     "type": "integer"
                 },
@@ -491,14 +1946,67 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "rank": {
+                    "description": "Rank is higher for better matches, matches in the name count the most",
+                    "type": "number"
+                },
                 "start_date": {
                     "type": "string"
                 },
+                "status": {
+                    "$ref": "#/definitions/model.EventStatus"
+                },
                 "total_tickets": {
                     "type": "integer"
                 }
             }
         },
+        "model.EventStatus": {
+            "type": "integer",
+            "enum": [
+                0,
+                1,
+                2,
+                3,
+                4
+            ],
+            "x-enum-varnames": [
+                "EventStatus_Draft",
+                "EventStatus_Published",
+                "EventStatus_Ongoing",
+                "EventStatus_Completed",
+                "EventStatus_Cancelled"
+            ]
+        },
+        "model.EventTicket": {
+            "type": "object",
+            "properties": {
+                "buyer_email": {
+                    "type": "string"
+                },
+                "buyer_name": {
+                    "type": "string"
+                },
+                "cancelled": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
         "model.ListEventsResponse": {
             "type": "object",
             "properties": {
@@ -527,6 +2035,76 @@ const docTemplate = `{
                 }
             }
         },
+        "model.ListTicketsResponse": {
+            "type": "object",
+            "properties": {
+                "next_offset": {
+                    "type": "integer"
+                },
+                "records": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.UserTicket"
+                    }
+                }
+            }
+        },
+        "model.Refund": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/model.RefundStatus"
+                },
+                "ticket_id": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.RefundStatus": {
+            "type": "integer",
+            "enum": [
+                0,
+                1,
+                2
+            ],
+            "x-enum-varnames": [
+                "RefundStatus_Pending",
+                "RefundStatus_Refunded",
+                "RefundStatus_Failed"
+            ]
+        },
+        "model.SearchEventsResponse": {
+            "type": "object",
+            "properties": {
+                "next_offset": {
+                    "type": "integer"
+                },
+                "records": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.EventSearchResult"
+                    }
+                }
+            }
+        },
         "model.Ticket": {
             "type": "object",
             "properties": {
@@ -583,6 +2161,32 @@ const docTemplate = `{
                 "UserHostRequestStatus_Approved"
             ]
         },
+        "model.UserTicket": {
+            "type": "object",
+            "properties": {
+                "cancelled": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "event": {
+                    "$ref": "#/definitions/model.Event"
+                },
+                "event_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
         "sql.NullInt64": {
             "type": "object",
             "properties": {
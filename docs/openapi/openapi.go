@@ -0,0 +1,8 @@
+// Package openapi embeds the OpenAPI spec generated from the protos
+// so that the gateway server can serve it.
+package openapi
+
+import "embed"
+
+//go:embed *.swagger.json
+var FS embed.FS
@@ -0,0 +1,176 @@
+package gapi
+
+import (
+	"github.com/hibiken/asynq"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func convertUser(user *model.User) *pb.UserResponse {
+	return &pb.UserResponse{
+		Name:              user.Name,
+		Email:             user.Email,
+		CreatedAt:         timestamppb.New(user.CreatedAt),
+		PasswordUpdatedAt: timestamppb.New(user.PasswordUpdatedAt),
+		Role:              user.Role.ToProto(),
+		IsEmailVerified:   user.IsEmailVerified,
+	}
+}
+
+func convertEvent(event *model.Event) *pb.Event {
+	return &pb.Event{
+		Id:           event.ID,
+		HostId:       event.HostID,
+		Name:         event.Name,
+		Description:  event.Description,
+		Location:     event.Location,
+		TotalTickets: event.TotalTickets,
+		LeftTickets:  event.LeftTickets,
+		StartDate:    timestamppb.New(event.StartDate),
+		EndDate:      timestamppb.New(event.EndDate),
+		CreatedAt:    timestamppb.New(event.CreatedAt),
+
+		CancellationCutoffHours: event.CancellationCutoffHours,
+		Status:                  event.Status.ToProto(),
+	}
+}
+
+func convertEvents(events []model.Event) []*pb.Event {
+	res := make([]*pb.Event, 0, len(events))
+	for i := range events {
+		res = append(res, convertEvent(&events[i]))
+	}
+	return res
+}
+
+func convertEventSearchResults(results []model.EventSearchResult) []*pb.EventSearchResult {
+	res := make([]*pb.EventSearchResult, 0, len(results))
+	for i := range results {
+		res = append(res, &pb.EventSearchResult{
+			Event:    convertEvent(&results[i].Event),
+			Rank:     results[i].Rank,
+			Headline: results[i].Headline,
+		})
+	}
+	return res
+}
+
+func convertTicket(ticket *model.Ticket) *pb.Ticket {
+	return &pb.Ticket{
+		Id:        ticket.ID,
+		UserId:    ticket.UserID,
+		EventId:   ticket.EventID,
+		Quantity:  ticket.Quantity,
+		CreatedAt: timestamppb.New(ticket.CreatedAt),
+	}
+}
+
+func convertUserTickets(tickets []model.UserTicket) []*pb.UserTicket {
+	res := make([]*pb.UserTicket, 0, len(tickets))
+	for i := range tickets {
+		res = append(res, &pb.UserTicket{
+			Ticket:    convertTicket(&tickets[i].Ticket),
+			Event:     convertEvent(&tickets[i].Event),
+			Cancelled: tickets[i].Cancelled,
+		})
+	}
+	return res
+}
+
+func convertEventTickets(tickets []model.EventTicket) []*pb.EventTicket {
+	res := make([]*pb.EventTicket, 0, len(tickets))
+	for i := range tickets {
+		res = append(res, &pb.EventTicket{
+			Ticket:     convertTicket(&tickets[i].Ticket),
+			BuyerName:  tickets[i].BuyerName,
+			BuyerEmail: tickets[i].BuyerEmail,
+			Cancelled:  tickets[i].Cancelled,
+		})
+	}
+	return res
+}
+
+func convertEventSalesStats(stats *model.EventSalesStats) *pb.EventSalesStats {
+	daily := make([]*pb.DailySales, 0, len(stats.Daily))
+	for _, sales := range stats.Daily {
+		daily = append(daily, &pb.DailySales{
+			Day:     timestamppb.New(sales.Day),
+			Tickets: sales.Tickets,
+		})
+	}
+
+	return &pb.EventSalesStats{
+		Sold:      stats.Sold,
+		Remaining: stats.Remaining,
+		Cancelled: stats.Cancelled,
+		Daily:     daily,
+	}
+}
+
+func convertRefund(refund *model.Refund) *pb.Refund {
+	return &pb.Refund{
+		Id:        refund.ID,
+		TicketId:  refund.TicketID,
+		UserId:    refund.UserID,
+		EventId:   refund.EventID,
+		Quantity:  refund.Quantity,
+		Status:    refund.Status.ToProto(),
+		CreatedAt: timestamppb.New(refund.CreatedAt),
+		UpdatedAt: timestamppb.New(refund.UpdatedAt),
+	}
+}
+
+func convertUserHostRequest(request *model.UserHostRequest) *pb.UserHostRequest {
+	return &pb.UserHostRequest{
+		Id:          request.ID,
+		UserId:      request.UserID,
+		ModeratorId: request.ModeratorID.Int64,
+		Status:      request.Status.ToProto(),
+		CreatedAt:   timestamppb.New(request.CreatedAt),
+		UpdatedAt:   timestamppb.New(request.UpdatedAt),
+	}
+}
+
+func convertUserHostRequests(requests []*model.UserHostRequest) []*pb.UserHostRequest {
+	res := make([]*pb.UserHostRequest, 0, len(requests))
+	for _, request := range requests {
+		res = append(res, convertUserHostRequest(request))
+	}
+	return res
+}
+
+func convertTask(info *asynq.TaskInfo) *pb.Task {
+	task := &pb.Task{
+		Id:        info.ID,
+		Queue:     info.Queue,
+		Type:      info.Type,
+		State:     info.State.String(),
+		MaxRetry:  int32(info.MaxRetry),
+		Retried:   int32(info.Retried),
+		LastError: info.LastErr,
+	}
+
+	// The payload is left out when it is not a json object
+	payload := &structpb.Struct{}
+	if err := protojson.Unmarshal(info.Payload, payload); err == nil {
+		task.Payload = payload
+	}
+	if !info.LastFailedAt.IsZero() {
+		task.LastFailedAt = timestamppb.New(info.LastFailedAt)
+	}
+	if !info.NextProcessAt.IsZero() {
+		task.NextProcessAt = timestamppb.New(info.NextProcessAt)
+	}
+	return task
+}
+
+func convertTasks(infos []*asynq.TaskInfo) []*pb.Task {
+	res := make([]*pb.Task, 0, len(infos))
+	for _, info := range infos {
+		res = append(res, convertTask(info))
+	}
+	return res
+}
@@ -0,0 +1,189 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/yashagw/event-management-api/db/model"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// methodAccess describes who is allowed to call a gRPC method.
+// A public method needs no token, a method without roles only needs a valid
+// token and a method with roles also needs the user to have one of them.
+// A method requiring a verified email also needs the user to have verified it.
+type methodAccess struct {
+	public        bool
+	roles         []model.UserRole
+	verifiedEmail bool
+}
+
+var (
+	publicAccess        = methodAccess{public: true}
+	authenticatedAccess = methodAccess{}
+)
+
+func roleAccess(roles ...model.UserRole) methodAccess {
+	return methodAccess{roles: roles}
+}
+
+func (access methodAccess) withVerifiedEmail() methodAccess {
+	access.verifiedEmail = true
+	return access
+}
+
+const servicePrefix = "/pb.EventManagement/"
+
+// methodAccessRules maps each full gRPC method name to its access rule.
+// Methods that are not listed here require a valid access token.
+var methodAccessRules = map[string]methodAccess{
+	servicePrefix + "CreateUser":       publicAccess,
+	servicePrefix + "LoginUser":        publicAccess,
+	servicePrefix + "RenewAccessToken": publicAccess,
+	servicePrefix + "VerifyEmail":      publicAccess,
+	servicePrefix + "ListEvents":       publicAccess,
+	servicePrefix + "GetEvent":         publicAccess,
+	servicePrefix + "SearchEvents":     publicAccess,
+
+	servicePrefix + "LogoutUser":        authenticatedAccess,
+	servicePrefix + "LogoutAllSessions": authenticatedAccess,
+	servicePrefix + "ResendVerifyEmail": authenticatedAccess,
+
+	servicePrefix + "CreateTicket": roleAccess(model.UserRole_User).withVerifiedEmail(),
+	servicePrefix + "ListTickets":  roleAccess(model.UserRole_User).withVerifiedEmail(),
+	servicePrefix + "CancelTicket": roleAccess(model.UserRole_User).withVerifiedEmail(),
+	servicePrefix + "BecomeHost":   roleAccess(model.UserRole_User).withVerifiedEmail(),
+
+	servicePrefix + "CreateEvent":      roleAccess(model.UserRole_Host),
+	servicePrefix + "UpdateEvent":      roleAccess(model.UserRole_Host),
+	servicePrefix + "PublishEvent":     roleAccess(model.UserRole_Host),
+	servicePrefix + "UnpublishEvent":   roleAccess(model.UserRole_Host),
+	servicePrefix + "CancelEvent":      roleAccess(model.UserRole_Host),
+	servicePrefix + "ListEventTickets": roleAccess(model.UserRole_Host),
+	servicePrefix + "ListHostEvents":   roleAccess(model.UserRole_Host),
+	servicePrefix + "SearchHostEvents": roleAccess(model.UserRole_Host),
+
+	servicePrefix + "ListPendingUserHostRequests":      roleAccess(model.UserRole_Moderator, model.UserRole_Admin),
+	servicePrefix + "ApproveDisapproveUserHostRequest": roleAccess(model.UserRole_Moderator, model.UserRole_Admin),
+
+	servicePrefix + "ListFailedTasks": roleAccess(model.UserRole_Admin),
+	servicePrefix + "GetTask":         roleAccess(model.UserRole_Admin),
+	servicePrefix + "RetryTask":       roleAccess(model.UserRole_Admin),
+	servicePrefix + "DeleteTask":      roleAccess(model.UserRole_Admin),
+
+	"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo": publicAccess,
+	"/grpc.reflection.v1.ServerReflection/ServerReflectionInfo":      publicAccess,
+}
+
+func (access methodAccess) allows(role model.UserRole) bool {
+	for _, r := range access.roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+func accessForMethod(fullMethod string) methodAccess {
+	access, ok := methodAccessRules[fullMethod]
+	if !ok {
+		return authenticatedAccess
+	}
+	return access
+}
+
+// authenticate applies the access rule of the method and returns a context
+// holding the token payload.
+// Roles are checked against the token first, the database is only queried when
+// the token role is not allowed since the role may have changed after the token
+// was issued (e.g. a user whose host request was just approved), or when the
+// method requires a verified email.
+func (server *Server) authenticate(ctx context.Context, fullMethod string) (context.Context, error) {
+	access := accessForMethod(fullMethod)
+	if access.public {
+		return ctx, nil
+	}
+
+	payload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: %v", err)
+	}
+
+	revoked, err := server.revocationList.IsRevoked(ctx, payload.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check token revocation: %v", err)
+	}
+	if revoked {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: token has been revoked")
+	}
+	ctx = context.WithValue(ctx, authorizationPayloadKey, payload)
+
+	roleAllowed := len(access.roles) == 0 || access.allows(payload.Role)
+	if roleAllowed && !access.verifiedEmail {
+		return ctx, nil
+	}
+
+	// The verified flag is not part of the token, so it is always read from the database
+	user, err := server.provider.GetUserByEmail(ctx, payload.Username)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.Unauthenticated, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to find user: %v", err)
+	}
+
+	if !roleAllowed {
+		if !access.allows(user.Role) {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+		payload.Role = user.Role
+	}
+
+	if access.verifiedEmail && !user.IsEmailVerified {
+		return nil, status.Errorf(codes.PermissionDenied, "email is not verified")
+	}
+
+	return ctx, nil
+}
+
+// UnaryAuthInterceptor authenticates unary calls according to methodAccessRules
+func (server *Server) UnaryAuthInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	ctx, err := server.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// authServerStream overrides the context of a server stream
+type authServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (stream *authServerStream) Context() context.Context {
+	return stream.ctx
+}
+
+// StreamAuthInterceptor authenticates streaming calls according to methodAccessRules
+func (server *Server) StreamAuthInterceptor(
+	srv interface{},
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	ctx, err := server.authenticate(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &authServerStream{ServerStream: stream, ctx: ctx})
+}
@@ -0,0 +1,290 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yashagw/event-management-api/db/mock"
+	"github.com/yashagw/event-management-api/db/model"
+	mockrv "github.com/yashagw/event-management-api/revocation/mock"
+	"github.com/yashagw/event-management-api/token"
+	"github.com/yashagw/event-management-api/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func newContextWithBearerToken(t *testing.T, tokenMaker token.Maker, user *model.User, duration time.Duration) context.Context {
+	accessToken, _, err := tokenMaker.CreateToken(user.Email, user.ID, user.Role, token.TokenTypeAccess, duration)
+	require.NoError(t, err)
+
+	bearerToken := fmt.Sprintf("%s %s", authorizationBearer, accessToken)
+	md := metadata.MD{
+		authorizationHeader: []string{bearerToken},
+	}
+
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func TestUnaryAuthInterceptor(t *testing.T) {
+	user := &model.User{
+		ID:    util.RandomInt(1, 1000),
+		Name:  util.RandomName(),
+		Email: util.RandomEmail(),
+		Role:  model.UserRole_User,
+	}
+	host := &model.User{
+		ID:    util.RandomInt(1, 1000),
+		Name:  util.RandomName(),
+		Email: util.RandomEmail(),
+		Role:  model.UserRole_Host,
+	}
+
+	testCases := []struct {
+		name       string
+		method     string
+		buildCtx   func(t *testing.T, tokenMaker token.Maker) context.Context
+		buildStubs func(provider *mockdb.MockProvider)
+		checkCtx   func(t *testing.T, ctx context.Context)
+		revoked    bool
+		code       codes.Code
+	}{
+		{
+			name:   "PublicWithoutToken",
+			method: servicePrefix + "ListEvents",
+			buildCtx: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {},
+			checkCtx: func(t *testing.T, ctx context.Context) {
+				require.Nil(t, authPayloadFromContext(ctx))
+			},
+			code: codes.OK,
+		},
+		{
+			name:   "AuthenticatedOK",
+			method: servicePrefix + "Unknown",
+			buildCtx: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {},
+			checkCtx: func(t *testing.T, ctx context.Context) {
+				payload := authPayloadFromContext(ctx)
+				require.NotNil(t, payload)
+				require.Equal(t, user.Email, payload.Username)
+				require.Equal(t, user.ID, payload.UserID)
+			},
+			code: codes.OK,
+		},
+		{
+			name:   "NoMetadata",
+			method: servicePrefix + "CreateEvent",
+			buildCtx: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {},
+			code:       codes.Unauthenticated,
+		},
+		{
+			name:   "UnsupportedAuthorization",
+			method: servicePrefix + "CreateEvent",
+			buildCtx: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				md := metadata.MD{authorizationHeader: []string{"basic abc"}}
+				return metadata.NewIncomingContext(context.Background(), md)
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {},
+			code:       codes.Unauthenticated,
+		},
+		{
+			name:   "ExpiredToken",
+			method: servicePrefix + "CreateEvent",
+			buildCtx: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, host, -time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {},
+			code:       codes.Unauthenticated,
+		},
+		{
+			name:   "RefreshToken",
+			method: servicePrefix + "CreateEvent",
+			buildCtx: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				refreshToken, _, err := tokenMaker.CreateToken(host.Email, host.ID, host.Role, token.TokenTypeRefresh, time.Minute)
+				require.NoError(t, err)
+
+				md := metadata.MD{authorizationHeader: []string{fmt.Sprintf("%s %s", authorizationBearer, refreshToken)}}
+				return metadata.NewIncomingContext(context.Background(), md)
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {},
+			code:       codes.Unauthenticated,
+		},
+		{
+			name:   "RevokedToken",
+			method: servicePrefix + "CreateEvent",
+			buildCtx: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, host, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {},
+			revoked:    true,
+			code:       codes.Unauthenticated,
+		},
+		{
+			name:   "RoleOK",
+			method: servicePrefix + "CreateEvent",
+			buildCtx: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, host, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkCtx: func(t *testing.T, ctx context.Context) {
+				require.Equal(t, host.ID, authPayloadFromContext(ctx).UserID)
+			},
+			code: codes.OK,
+		},
+		{
+			name:   "StaleTokenRole",
+			method: servicePrefix + "CreateEvent",
+			buildCtx: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				staleHost := *host
+				staleHost.Role = model.UserRole_User
+				return newContextWithBearerToken(t, tokenMaker, &staleHost, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), host.Email).Times(1).Return(host, nil)
+			},
+			checkCtx: func(t *testing.T, ctx context.Context) {
+				require.Equal(t, model.UserRole_Host, authPayloadFromContext(ctx).Role)
+			},
+			code: codes.OK,
+		},
+		{
+			name:   "WrongRole",
+			method: servicePrefix + "CreateEvent",
+			buildCtx: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(user, nil)
+			},
+			code: codes.PermissionDenied,
+		},
+		{
+			name:   "UserNotFound",
+			method: servicePrefix + "CreateEvent",
+			buildCtx: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(nil, sql.ErrNoRows)
+			},
+			code: codes.Unauthenticated,
+		},
+		{
+			name:   "VerifiedEmailOK",
+			method: servicePrefix + "CreateTicket",
+			buildCtx: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				verifiedUser := *user
+				verifiedUser.IsEmailVerified = true
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(&verifiedUser, nil)
+			},
+			code: codes.OK,
+		},
+		{
+			name:   "EmailNotVerified",
+			method: servicePrefix + "CreateTicket",
+			buildCtx: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user, time.Minute)
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(user, nil)
+			},
+			code: codes.PermissionDenied,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			provider := mockdb.NewMockProvider(ctrl)
+			tc.buildStubs(provider)
+			revocationList := mockrv.NewMockList(ctrl)
+			revocationList.EXPECT().IsRevoked(gomock.Any(), gomock.Any()).AnyTimes().Return(tc.revoked, nil)
+
+			server := newTestServerWithRevocationList(t, provider, nil, revocationList)
+			ctx := tc.buildCtx(t, server.tokenMaker)
+
+			called := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				if tc.checkCtx != nil {
+					tc.checkCtx(t, ctx)
+				}
+				return nil, nil
+			}
+
+			info := &grpc.UnaryServerInfo{FullMethod: tc.method}
+			_, err := server.UnaryAuthInterceptor(ctx, nil, info, handler)
+			require.Equal(t, tc.code, status.Code(err))
+			require.Equal(t, tc.code == codes.OK, called)
+		})
+	}
+}
+
+func TestMethodAccessRules(t *testing.T) {
+	allRoles := []model.UserRole{
+		model.UserRole_User,
+		model.UserRole_Host,
+		model.UserRole_Moderator,
+		model.UserRole_Admin,
+	}
+
+	testCases := []struct {
+		method        string
+		public        bool
+		roles         []model.UserRole
+		verifiedEmail bool
+	}{
+		{method: "ListEvents", public: true},
+		{method: "GetEvent", public: true},
+		{method: "SearchEvents", public: true},
+		{method: "CreateTicket", roles: []model.UserRole{model.UserRole_User}, verifiedEmail: true},
+		{method: "ListTickets", roles: []model.UserRole{model.UserRole_User}, verifiedEmail: true},
+		{method: "CancelTicket", roles: []model.UserRole{model.UserRole_User}, verifiedEmail: true},
+		{method: "BecomeHost", roles: []model.UserRole{model.UserRole_User}, verifiedEmail: true},
+		{method: "CreateEvent", roles: []model.UserRole{model.UserRole_Host}},
+		{method: "UpdateEvent", roles: []model.UserRole{model.UserRole_Host}},
+		{method: "PublishEvent", roles: []model.UserRole{model.UserRole_Host}},
+		{method: "UnpublishEvent", roles: []model.UserRole{model.UserRole_Host}},
+		{method: "CancelEvent", roles: []model.UserRole{model.UserRole_Host}},
+		{method: "ListEventTickets", roles: []model.UserRole{model.UserRole_Host}},
+		{method: "ListHostEvents", roles: []model.UserRole{model.UserRole_Host}},
+		{method: "SearchHostEvents", roles: []model.UserRole{model.UserRole_Host}},
+		{method: "ListPendingUserHostRequests", roles: []model.UserRole{model.UserRole_Moderator, model.UserRole_Admin}},
+		{method: "ApproveDisapproveUserHostRequest", roles: []model.UserRole{model.UserRole_Moderator, model.UserRole_Admin}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.method, func(t *testing.T) {
+			access := accessForMethod(servicePrefix + tc.method)
+			require.Equal(t, tc.public, access.public)
+			require.Equal(t, tc.verifiedEmail, access.verifiedEmail)
+			for _, role := range allRoles {
+				allowed := false
+				for _, r := range tc.roles {
+					allowed = allowed || r == role
+				}
+				require.Equal(t, allowed, access.allows(role), "role %v", role)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/yashagw/event-management-api/db"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/revocation"
+	mockrv "github.com/yashagw/event-management-api/revocation/mock"
+	"github.com/yashagw/event-management-api/token"
+	"github.com/yashagw/event-management-api/util"
+	"github.com/yashagw/event-management-api/worker"
+)
+
+// newTestServer creates a server whose revocation list reports every token as valid
+func newTestServer(t *testing.T, provider db.Provider, distributor worker.TaskDistributor) *Server {
+	ctrl := gomock.NewController(t)
+	revocationList := mockrv.NewMockList(ctrl)
+	revocationList.EXPECT().IsRevoked(gomock.Any(), gomock.Any()).AnyTimes().Return(false, nil)
+
+	return newTestServerWithRevocationList(t, provider, distributor, revocationList)
+}
+
+// newTestServerWithInspector creates a server whose admin methods use inspector
+func newTestServerWithInspector(t *testing.T, provider db.Provider, inspector worker.TaskInspector) *Server {
+	server := newTestServer(t, provider, nil)
+	server.inspector = inspector
+	return server
+}
+
+func newTestServerWithRevocationList(
+	t *testing.T,
+	provider db.Provider,
+	distributor worker.TaskDistributor,
+	revocationList revocation.List,
+) *Server {
+	config := util.Config{
+		TokenSymmetricKey:    util.RandomString(32),
+		AccessTokenDuration:  time.Minute,
+		RefreshTokenDuration: time.Hour,
+		VerifyEmailInterval:  time.Minute,
+	}
+	server, err := NewServer(config, provider, distributor, nil, revocationList)
+	require.NoError(t, err)
+
+	return server
+}
+
+// newContextWithPayload returns a context holding the access token payload of
+// user, as the auth interceptor leaves it for the handlers
+func newContextWithPayload(t *testing.T, user *model.User) context.Context {
+	payload, err := token.NewPayload(user.Email, user.ID, user.Role, token.TokenTypeAccess, time.Minute)
+	require.NoError(t, err)
+
+	return context.WithValue(context.Background(), authorizationPayloadKey, payload)
+}
+
+func randomUser(role model.UserRole) *model.User {
+	return &model.User{
+		ID:              util.RandomInt(1, 1000),
+		Name:            util.RandomName(),
+		Email:           util.RandomEmail(),
+		Role:            role,
+		IsEmailVerified: true,
+	}
+}
+
+func randomEvent(hostID int64) *model.Event {
+	totalTickets := util.RandomInt(1, 100)
+	startDate := time.Now().Add(24 * time.Hour).UTC()
+
+	return &model.Event{
+		ID:           util.RandomInt(1, 1000),
+		HostID:       hostID,
+		Name:         util.RandomName(),
+		Description:  util.RandomString(20),
+		Location:     util.RandomString(10),
+		TotalTickets: totalTickets,
+		LeftTickets:  totalTickets,
+		StartDate:    startDate,
+		EndDate:      startDate.Add(2 * time.Hour),
+		CreatedAt:    time.Now().UTC(),
+		Status:       model.EventStatus_Published,
+	}
+}
@@ -0,0 +1,59 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"github.com/yashagw/event-management-api/worker"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ApproveDisapproveUserHostRequest(context context.Context, req *pb.ApproveDisapproveUserHostRequestRequest) (*pb.ApproveDisapproveUserHostRequestResponse, error) {
+	payload := authPayloadFromContext(context)
+
+	if req.GetRequestId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "request_id is required")
+	}
+
+	_, err := server.provider.ApproveDisapproveRequestToBecomeHostTx(context, model.ApproveDisapproveRequestToBecomeHostTxParams{
+		ApproveDisapproveRequestToBecomeHostParams: model.ApproveDisapproveRequestToBecomeHostParams{
+			RequestID:   req.GetRequestId(),
+			Approved:    req.GetApproved(),
+			ModeratorID: payload.UserID,
+		},
+		AfterUpdate: func(request *model.UserHostRequest) ([]model.CreateOutboxMessageParams, error) {
+			taskPayload := &worker.PayloadSendHostRequestDecision{
+				UserID:    request.UserID,
+				RequestID: request.ID,
+				Approved:  request.Status == model.UserHostRequestStatus_Approved,
+			}
+			opts := []asynq.Option{
+				asynq.MaxRetry(10),
+				asynq.Timeout(10 * time.Second),
+				asynq.Queue(worker.QueueDefault),
+			}
+			message, err := worker.NewOutboxMessage(worker.TaskSendHostRequestDecision, taskPayload, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return []model.CreateOutboxMessageParams{message}, nil
+		},
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "request not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to approve/disapprove request: %v", err)
+	}
+
+	res := &pb.ApproveDisapproveUserHostRequestResponse{
+		Message: "request approved/disapproved",
+	}
+
+	return res, nil
+}
@@ -0,0 +1,106 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yashagw/event-management-api/db/mock"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"github.com/yashagw/event-management-api/util"
+	"github.com/yashagw/event-management-api/worker"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestApproveDisapproveUserHostRequest(t *testing.T) {
+	moderator := randomUser(model.UserRole_Moderator)
+	request := &model.UserHostRequest{
+		ID:          util.RandomInt(1, 1000),
+		UserID:      util.RandomInt(1, 1000),
+		ModeratorID: sql.NullInt64{Int64: moderator.ID, Valid: true},
+		Status:      model.UserHostRequestStatus_Approved,
+		CreatedAt:   time.Now().UTC(),
+		UpdatedAt:   time.Now().UTC(),
+	}
+
+	testCases := []struct {
+		name       string
+		req        *pb.ApproveDisapproveUserHostRequestRequest
+		buildStubs func(provider *mockdb.MockProvider)
+		code       codes.Code
+	}{
+		{
+			name: "OK",
+			req:  &pb.ApproveDisapproveUserHostRequestRequest{RequestId: request.ID, Approved: true},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().
+					ApproveDisapproveRequestToBecomeHostTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg model.ApproveDisapproveRequestToBecomeHostTxParams) (*model.UserHostRequest, error) {
+						require.Equal(t, model.ApproveDisapproveRequestToBecomeHostParams{
+							RequestID:   request.ID,
+							Approved:    true,
+							ModeratorID: moderator.ID,
+						}, arg.ApproveDisapproveRequestToBecomeHostParams)
+
+						messages, err := arg.AfterUpdate(request)
+						require.NoError(t, err)
+						require.Len(t, messages, 1)
+						require.Equal(t, worker.TaskSendHostRequestDecision, messages[0].TaskType)
+
+						var taskPayload worker.PayloadSendHostRequestDecision
+						require.NoError(t, json.Unmarshal(messages[0].Payload, &taskPayload))
+						require.Equal(t, request.UserID, taskPayload.UserID)
+						require.True(t, taskPayload.Approved)
+
+						return request, nil
+					})
+			},
+			code: codes.OK,
+		},
+		{
+			name: "InvalidRequestID",
+			req:  &pb.ApproveDisapproveUserHostRequestRequest{RequestId: 0, Approved: true},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().ApproveDisapproveRequestToBecomeHostTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "NotFound",
+			req:  &pb.ApproveDisapproveUserHostRequestRequest{RequestId: request.ID},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().ApproveDisapproveRequestToBecomeHostTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrNoRows)
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: "InternalError",
+			req:  &pb.ApproveDisapproveUserHostRequestRequest{RequestId: request.ID},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().ApproveDisapproveRequestToBecomeHostTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			code: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			provider := mockdb.NewMockProvider(ctrl)
+			tc.buildStubs(provider)
+
+			server := newTestServer(t, provider, nil)
+			_, err := server.ApproveDisapproveUserHostRequest(newContextWithPayload(t, moderator), tc.req)
+			require.Equal(t, tc.code, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+	"github.com/yashagw/event-management-api/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) BecomeHost(context context.Context, req *pb.BecomeHostRequest) (*pb.BecomeHostResponse, error) {
+	payload := authPayloadFromContext(context)
+
+	// Create request to become host
+	request, err := server.provider.CreateRequestToBecomeHost(context, payload.UserID)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code.Name() {
+			case "unique_violation":
+				return nil, status.Errorf(codes.AlreadyExists, "request to become host already exists")
+			}
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create request to become host: %v", err)
+	}
+
+	res := &pb.BecomeHostResponse{
+		Request: convertUserHostRequest(request),
+	}
+
+	return res, nil
+}
@@ -0,0 +1,74 @@
+package gapi
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yashagw/event-management-api/db/mock"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"github.com/yashagw/event-management-api/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBecomeHost(t *testing.T) {
+	user := randomUser(model.UserRole_User)
+	request := &model.UserHostRequest{
+		ID:        util.RandomInt(1, 1000),
+		UserID:    user.ID,
+		Status:    model.UserHostRequestStatus_Pending,
+		CreatedAt: time.Now().UTC(),
+		UpdatedAt: time.Now().UTC(),
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(provider *mockdb.MockProvider)
+		code       codes.Code
+	}{
+		{
+			name: "OK",
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().CreateRequestToBecomeHost(gomock.Any(), user.ID).Times(1).Return(request, nil)
+			},
+			code: codes.OK,
+		},
+		{
+			name: "AlreadyRequested",
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().CreateRequestToBecomeHost(gomock.Any(), user.ID).Times(1).Return(nil, &pq.Error{Code: "23505"})
+			},
+			code: codes.AlreadyExists,
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().CreateRequestToBecomeHost(gomock.Any(), user.ID).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			code: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			provider := mockdb.NewMockProvider(ctrl)
+			tc.buildStubs(provider)
+
+			server := newTestServer(t, provider, nil)
+			res, err := server.BecomeHost(newContextWithPayload(t, user), &pb.BecomeHostRequest{})
+			require.Equal(t, tc.code, status.Code(err))
+			if tc.code == codes.OK {
+				require.Equal(t, request.ID, res.GetRequest().GetId())
+				require.Equal(t, pb.UserHostRequestStatus_UserHostRequestStatus_Pending, res.GetRequest().GetStatus())
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"github.com/yashagw/event-management-api/worker"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CancelEvent(context context.Context, req *pb.CancelEventRequest) (*pb.CancelEventResponse, error) {
+	payload := authPayloadFromContext(context)
+
+	if req.GetEventId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "event_id must be positive")
+	}
+
+	event, err := server.provider.CancelEventTx(context, model.CancelEventTxParams{
+		CancelEventParams: model.CancelEventParams{
+			EventID: req.GetEventId(),
+			HostID:  payload.UserID,
+		},
+		AfterCancel: worker.NewEventCancelledMessages,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, status.Errorf(codes.NotFound, "event not found")
+		case errors.Is(err, model.ErrEventNotOwned):
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		case errors.Is(err, model.ErrEventCancelled), errors.Is(err, model.ErrEventEnded):
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to cancel event: %v", err)
+	}
+
+	res := &pb.CancelEventResponse{
+		Event: convertEvent(event),
+	}
+
+	return res, nil
+}
@@ -0,0 +1,122 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yashagw/event-management-api/db/mock"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"github.com/yashagw/event-management-api/worker"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCancelEvent(t *testing.T) {
+	host := randomUser(model.UserRole_Host)
+	event := randomEvent(host.ID)
+	event.Status = model.EventStatus_Cancelled
+
+	testCases := []struct {
+		name       string
+		eventID    int64
+		buildStubs func(provider *mockdb.MockProvider)
+		code       codes.Code
+	}{
+		{
+			name:    "OK",
+			eventID: event.ID,
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().
+					CancelEventTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg model.CancelEventTxParams) (*model.Event, error) {
+						require.Equal(t, model.CancelEventParams{
+							EventID: event.ID,
+							HostID:  host.ID,
+						}, arg.CancelEventParams)
+
+						// Every ticket holder gets an email
+						messages, err := arg.AfterCancel(event, []int64{1, 2})
+						require.NoError(t, err)
+						require.Len(t, messages, 2)
+						for _, message := range messages {
+							require.Equal(t, worker.TaskSendEventCancelled, message.TaskType)
+						}
+
+						return event, nil
+					})
+			},
+			code: codes.OK,
+		},
+		{
+			name:    "InvalidID",
+			eventID: 0,
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().CancelEventTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name:    "NotFound",
+			eventID: event.ID,
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().CancelEventTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrNoRows)
+			},
+			code: codes.NotFound,
+		},
+		{
+			name:    "NotOwned",
+			eventID: event.ID,
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().CancelEventTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrEventNotOwned)
+			},
+			code: codes.PermissionDenied,
+		},
+		{
+			name:    "AlreadyCancelled",
+			eventID: event.ID,
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().CancelEventTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrEventCancelled)
+			},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name:    "Ended",
+			eventID: event.ID,
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().CancelEventTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrEventEnded)
+			},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name:    "InternalError",
+			eventID: event.ID,
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().CancelEventTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			code: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			provider := mockdb.NewMockProvider(ctrl)
+			tc.buildStubs(provider)
+
+			server := newTestServer(t, provider, nil)
+			res, err := server.CancelEvent(newContextWithPayload(t, host), &pb.CancelEventRequest{EventId: tc.eventID})
+			require.Equal(t, tc.code, status.Code(err))
+			if tc.code == codes.OK {
+				require.Equal(t, event.ID, res.GetEvent().GetId())
+				require.Equal(t, pb.EventStatus_EventStatus_Cancelled, res.GetEvent().GetStatus())
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"github.com/yashagw/event-management-api/worker"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CancelTicket(context context.Context, req *pb.CancelTicketRequest) (*pb.CancelTicketResponse, error) {
+	payload := authPayloadFromContext(context)
+
+	if req.GetTicketId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ticket_id must be positive")
+	}
+
+	refund, err := server.provider.CancelTicketTx(context, model.CancelTicketTxParams{
+		CancelTicketParams: model.CancelTicketParams{
+			UserID:   payload.UserID,
+			TicketID: req.GetTicketId(),
+		},
+		AfterCancel: func(refund *model.Refund) ([]model.CreateOutboxMessageParams, error) {
+			taskPayload := &worker.PayloadSendTicketCancelled{
+				UserID:   refund.UserID,
+				TicketID: refund.TicketID,
+			}
+			opts := []asynq.Option{
+				asynq.MaxRetry(10),
+				asynq.Timeout(10 * time.Second),
+				asynq.Queue(worker.QueueDefault),
+			}
+			message, err := worker.NewOutboxMessage(worker.TaskSendTicketCancelled, taskPayload, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return []model.CreateOutboxMessageParams{message}, nil
+		},
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, status.Errorf(codes.NotFound, "ticket not found")
+		case errors.Is(err, model.ErrTicketAlreadyCancelled):
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		case errors.Is(err, model.ErrCancellationClosed):
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to cancel ticket: %v", err)
+	}
+
+	res := &pb.CancelTicketResponse{
+		Refund: convertRefund(refund),
+	}
+
+	return res, nil
+}
@@ -0,0 +1,126 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yashagw/event-management-api/db/mock"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"github.com/yashagw/event-management-api/util"
+	"github.com/yashagw/event-management-api/worker"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCancelTicket(t *testing.T) {
+	user := randomUser(model.UserRole_User)
+	refund := &model.Refund{
+		ID:        util.RandomInt(1, 1000),
+		TicketID:  util.RandomInt(1, 1000),
+		UserID:    user.ID,
+		EventID:   util.RandomInt(1, 1000),
+		Quantity:  2,
+		CreatedAt: time.Now().UTC(),
+		UpdatedAt: time.Now().UTC(),
+	}
+
+	testCases := []struct {
+		name       string
+		ticketID   int64
+		buildStubs func(provider *mockdb.MockProvider)
+		code       codes.Code
+	}{
+		{
+			name:     "OK",
+			ticketID: refund.TicketID,
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().
+					CancelTicketTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg model.CancelTicketTxParams) (*model.Refund, error) {
+						require.Equal(t, model.CancelTicketParams{
+							UserID:   user.ID,
+							TicketID: refund.TicketID,
+						}, arg.CancelTicketParams)
+
+						messages, err := arg.AfterCancel(refund)
+						require.NoError(t, err)
+						require.Len(t, messages, 1)
+						require.Equal(t, worker.TaskSendTicketCancelled, messages[0].TaskType)
+
+						var taskPayload worker.PayloadSendTicketCancelled
+						require.NoError(t, json.Unmarshal(messages[0].Payload, &taskPayload))
+						require.Equal(t, refund.TicketID, taskPayload.TicketID)
+						require.Equal(t, user.ID, taskPayload.UserID)
+
+						return refund, nil
+					})
+			},
+			code: codes.OK,
+		},
+		{
+			name:     "InvalidTicketID",
+			ticketID: 0,
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().CancelTicketTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name:     "NotFound",
+			ticketID: refund.TicketID,
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().CancelTicketTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrNoRows)
+			},
+			code: codes.NotFound,
+		},
+		{
+			name:     "AlreadyCancelled",
+			ticketID: refund.TicketID,
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().CancelTicketTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrTicketAlreadyCancelled)
+			},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name:     "CancellationClosed",
+			ticketID: refund.TicketID,
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().CancelTicketTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrCancellationClosed)
+			},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name:     "InternalError",
+			ticketID: refund.TicketID,
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().CancelTicketTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			code: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			provider := mockdb.NewMockProvider(ctrl)
+			tc.buildStubs(provider)
+
+			server := newTestServer(t, provider, nil)
+			res, err := server.CancelTicket(newContextWithPayload(t, user), &pb.CancelTicketRequest{TicketId: tc.ticketID})
+			require.Equal(t, tc.code, status.Code(err))
+			if tc.code == codes.OK {
+				require.Equal(t, refund.ID, res.GetRefund().GetId())
+				require.Equal(t, refund.TicketID, res.GetRefund().GetTicketId())
+			}
+		})
+	}
+}
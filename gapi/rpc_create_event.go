@@ -0,0 +1,46 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateEvent(context context.Context, req *pb.CreateEventRequest) (*pb.CreateEventResponse, error) {
+	payload := authPayloadFromContext(context)
+
+	if req.GetStartDate() == nil || req.GetEndDate() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "start_date and end_date are required")
+	}
+	if req.GetCancellationCutoffHours() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "cancellation_cutoff_hours must not be negative")
+	}
+
+	event, err := server.provider.CreateEvent(context, model.CreateEventParams{
+		HostID:       payload.UserID,
+		Name:         req.GetName(),
+		Description:  req.GetDescription(),
+		Location:     req.GetLocation(),
+		TotalTickets: req.GetTotalTickets(),
+		StartDate:    req.GetStartDate().AsTime(),
+		EndDate:      req.GetEndDate().AsTime(),
+
+		CancellationCutoffHours: req.GetCancellationCutoffHours(),
+	})
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidEventDates) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create event: %v", err)
+	}
+
+	res := &pb.CreateEventResponse{
+		Event: convertEvent(event),
+	}
+
+	return res, nil
+}
@@ -0,0 +1,117 @@
+package gapi
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yashagw/event-management-api/db/mock"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCreateEvent(t *testing.T) {
+	host := randomUser(model.UserRole_Host)
+	event := randomEvent(host.ID)
+
+	validRequest := func() *pb.CreateEventRequest {
+		return &pb.CreateEventRequest{
+			Name:                    event.Name,
+			Description:             event.Description,
+			Location:                event.Location,
+			TotalTickets:            event.TotalTickets,
+			StartDate:               timestamppb.New(event.StartDate),
+			EndDate:                 timestamppb.New(event.EndDate),
+			CancellationCutoffHours: 24,
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		req        func() *pb.CreateEventRequest
+		buildStubs func(provider *mockdb.MockProvider)
+		code       codes.Code
+	}{
+		{
+			name: "OK",
+			req:  validRequest,
+			buildStubs: func(provider *mockdb.MockProvider) {
+				arg := model.CreateEventParams{
+					HostID:       host.ID,
+					Name:         event.Name,
+					Description:  event.Description,
+					Location:     event.Location,
+					TotalTickets: event.TotalTickets,
+					StartDate:    event.StartDate,
+					EndDate:      event.EndDate,
+
+					CancellationCutoffHours: 24,
+				}
+				provider.EXPECT().CreateEvent(gomock.Any(), arg).Times(1).Return(event, nil)
+			},
+			code: codes.OK,
+		},
+		{
+			name: "MissingDates",
+			req: func() *pb.CreateEventRequest {
+				req := validRequest()
+				req.EndDate = nil
+				return req
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().CreateEvent(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "NegativeCancellationCutoff",
+			req: func() *pb.CreateEventRequest {
+				req := validRequest()
+				req.CancellationCutoffHours = -1
+				return req
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().CreateEvent(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "InvalidDates",
+			req:  validRequest,
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().CreateEvent(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrInvalidEventDates)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "InternalError",
+			req:  validRequest,
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().CreateEvent(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			code: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			provider := mockdb.NewMockProvider(ctrl)
+			tc.buildStubs(provider)
+
+			server := newTestServer(t, provider, nil)
+			res, err := server.CreateEvent(newContextWithPayload(t, host), tc.req())
+			require.Equal(t, tc.code, status.Code(err))
+			if tc.code == codes.OK {
+				require.Equal(t, event.ID, res.GetEvent().GetId())
+				require.Equal(t, host.ID, res.GetEvent().GetHostId())
+			}
+		})
+	}
+}
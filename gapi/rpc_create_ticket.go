@@ -0,0 +1,65 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"github.com/yashagw/event-management-api/worker"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateTicket(context context.Context, req *pb.CreateTicketRequest) (*pb.CreateTicketResponse, error) {
+	payload := authPayloadFromContext(context)
+
+	if req.GetEventId() <= 0 || req.GetQuantity() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "event_id and quantity must be positive")
+	}
+
+	ticket, err := server.provider.CreateTicketTx(context, model.CreateTicketTxParams{
+		CreateTicketParams: model.CreateTicketParams{
+			EventID:  req.GetEventId(),
+			UserID:   payload.UserID,
+			Quantity: req.GetQuantity(),
+		},
+		AfterCreate: func(ticket *model.Ticket) ([]model.CreateOutboxMessageParams, error) {
+			taskPayload := &worker.PayloadSendTicketPurchased{
+				UserID:   ticket.UserID,
+				TicketID: ticket.ID,
+			}
+			opts := []asynq.Option{
+				asynq.MaxRetry(10),
+				asynq.Timeout(10 * time.Second),
+				asynq.Queue(worker.QueueDefault),
+			}
+			message, err := worker.NewOutboxMessage(worker.TaskSendTicketPurchased, taskPayload, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return []model.CreateOutboxMessageParams{message}, nil
+		},
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "event not found")
+		}
+		if errors.Is(err, model.ErrEventCancelled) || errors.Is(err, model.ErrEventNotPublished) || errors.Is(err, model.ErrEventEnded) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		if errors.Is(err, model.ErrNotEnoughTickets) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create ticket: %v", err)
+	}
+
+	res := &pb.CreateTicketResponse{
+		Ticket: convertTicket(ticket),
+	}
+
+	return res, nil
+}
@@ -0,0 +1,149 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yashagw/event-management-api/db/mock"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"github.com/yashagw/event-management-api/util"
+	"github.com/yashagw/event-management-api/worker"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateTicket(t *testing.T) {
+	user := randomUser(model.UserRole_User)
+	ticket := &model.Ticket{
+		ID:        util.RandomInt(1, 1000),
+		UserID:    user.ID,
+		EventID:   util.RandomInt(1, 1000),
+		Quantity:  2,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	testCases := []struct {
+		name       string
+		req        *pb.CreateTicketRequest
+		buildStubs func(provider *mockdb.MockProvider)
+		code       codes.Code
+	}{
+		{
+			name: "OK",
+			req:  &pb.CreateTicketRequest{EventId: ticket.EventID, Quantity: ticket.Quantity},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().
+					CreateTicketTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg model.CreateTicketTxParams) (*model.Ticket, error) {
+						require.Equal(t, model.CreateTicketParams{
+							EventID:  ticket.EventID,
+							UserID:   user.ID,
+							Quantity: ticket.Quantity,
+						}, arg.CreateTicketParams)
+
+						messages, err := arg.AfterCreate(ticket)
+						require.NoError(t, err)
+						require.Len(t, messages, 1)
+						require.Equal(t, worker.TaskSendTicketPurchased, messages[0].TaskType)
+
+						var taskPayload worker.PayloadSendTicketPurchased
+						require.NoError(t, json.Unmarshal(messages[0].Payload, &taskPayload))
+						require.Equal(t, ticket.ID, taskPayload.TicketID)
+						require.Equal(t, user.ID, taskPayload.UserID)
+
+						return ticket, nil
+					})
+			},
+			code: codes.OK,
+		},
+		{
+			name: "InvalidEventID",
+			req:  &pb.CreateTicketRequest{EventId: 0, Quantity: 1},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().CreateTicketTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "InvalidQuantity",
+			req:  &pb.CreateTicketRequest{EventId: ticket.EventID, Quantity: -1},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().CreateTicketTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "EventNotFound",
+			req:  &pb.CreateTicketRequest{EventId: ticket.EventID, Quantity: 1},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().CreateTicketTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrNoRows)
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: "EventCancelled",
+			req:  &pb.CreateTicketRequest{EventId: ticket.EventID, Quantity: 1},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().CreateTicketTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrEventCancelled)
+			},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name: "EventNotPublished",
+			req:  &pb.CreateTicketRequest{EventId: ticket.EventID, Quantity: 1},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().CreateTicketTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrEventNotPublished)
+			},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name: "EventEnded",
+			req:  &pb.CreateTicketRequest{EventId: ticket.EventID, Quantity: 1},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().CreateTicketTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrEventEnded)
+			},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name: "NotEnoughTickets",
+			req:  &pb.CreateTicketRequest{EventId: ticket.EventID, Quantity: 1},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().CreateTicketTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrNotEnoughTickets)
+			},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name: "InternalError",
+			req:  &pb.CreateTicketRequest{EventId: ticket.EventID, Quantity: 1},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().CreateTicketTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			code: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			provider := mockdb.NewMockProvider(ctrl)
+			tc.buildStubs(provider)
+
+			server := newTestServer(t, provider, nil)
+			res, err := server.CreateTicket(newContextWithPayload(t, user), tc.req)
+			require.Equal(t, tc.code, status.Code(err))
+			if tc.code == codes.OK {
+				require.Equal(t, ticket.ID, res.GetTicket().GetId())
+				require.Equal(t, ticket.Quantity, res.GetTicket().GetQuantity())
+			}
+		})
+	}
+}
@@ -12,7 +12,6 @@ import (
 	"github.com/yashagw/event-management-api/worker"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (server *Server) CreateUser(context context.Context, req *pb.CreateUserRequest) (*pb.CreateUserResponse, error) {
@@ -21,12 +20,39 @@ func (server *Server) CreateUser(context context.Context, req *pb.CreateUserRequ
 		return nil, status.Errorf(codes.Internal, "failed to hash password: %v", err)
 	}
 
-	reqParams := model.CreateUserParams{
-		Name:           req.GetName(),
-		Email:          req.GetEmail(),
-		HashedPassword: hashedPassword,
+	secretCode, err := util.RandomSecret(32)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate secret code: %v", err)
+	}
+
+	// The verification code and its email are written with the user, so
+	// retries of the task send the same code
+	arg := model.CreateUserTxParams{
+		CreateUserParams: model.CreateUserParams{
+			Name:           req.GetName(),
+			Email:          req.GetEmail(),
+			HashedPassword: hashedPassword,
+		},
+		SecretCode: secretCode,
+		AfterCreate: func(user *model.User, verifyEmail *model.VerifyEmail) ([]model.CreateOutboxMessageParams, error) {
+			taskPayload := &worker.PayloadSendEmailVerify{
+				Email:         user.Email,
+				VerifyEmailID: verifyEmail.ID,
+				NewUser:       true,
+			}
+			opts := []asynq.Option{
+				asynq.MaxRetry(3),
+				asynq.Timeout(10 * time.Second),
+				asynq.Queue(worker.QueueCritical),
+			}
+			message, err := worker.NewOutboxMessage(worker.TaskSendVerifyEmail, taskPayload, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return []model.CreateOutboxMessageParams{message}, nil
+		},
 	}
-	user, err := server.provider.CreateUser(context, reqParams)
+	user, err := server.provider.CreateUserTx(context, arg)
 	if err != nil {
 		if pqErr, ok := err.(*pq.Error); ok {
 			switch pqErr.Code.Name() {
@@ -37,27 +63,8 @@ func (server *Server) CreateUser(context context.Context, req *pb.CreateUserRequ
 		return nil, status.Errorf(codes.Internal, "failed to create user: %v", err)
 	}
 
-	taskPayload := worker.PayloadSendEmailVerify{
-		Email: user.Email,
-	}
-	opts := []asynq.Option{
-		asynq.MaxRetry(3),
-		asynq.Timeout(10 * time.Second),
-		asynq.Queue(worker.QueueCritical),
-	}
-	err = server.distributor.DistributeTaskSendEmailVerify(context, &taskPayload, opts...)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to distribute task: %v", err)
-	}
-
 	res := &pb.CreateUserResponse{
-		User: &pb.UserResponse{
-			Name:              user.Name,
-			Email:             user.Email,
-			CreatedAt:         timestamppb.New(user.CreatedAt),
-			PasswordUpdatedAt: timestamppb.New(user.PasswordUpdatedAt),
-			Role:              user.Role.ToProto(),
-		},
+		User: convertUser(user),
 	}
 
 	return res, nil
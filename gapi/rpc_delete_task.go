@@ -0,0 +1,24 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/yashagw/event-management-api/pb"
+)
+
+func (server *Server) DeleteTask(context context.Context, req *pb.DeleteTaskRequest) (*pb.DeleteTaskResponse, error) {
+	if err := validateTaskRequest(req.GetQueue(), req.GetTaskId()); err != nil {
+		return nil, err
+	}
+
+	err := server.inspector.DeleteTask(req.GetQueue(), req.GetTaskId())
+	if err != nil {
+		return nil, taskError(err, "delete task")
+	}
+
+	res := &pb.DeleteTaskResponse{
+		Message: "task deleted",
+	}
+
+	return res, nil
+}
@@ -0,0 +1,34 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetEvent(context context.Context, req *pb.GetEventRequest) (*pb.GetEventResponse, error) {
+	if req.GetEventId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "event_id is required")
+	}
+
+	event, err := server.provider.GetEvent(context, model.GetEventParams{
+		EventID:   req.GetEventId(),
+		Published: true,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "event not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get event: %v", err)
+	}
+
+	res := &pb.GetEventResponse{
+		Event: convertEvent(event),
+	}
+
+	return res, nil
+}
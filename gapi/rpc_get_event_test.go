@@ -0,0 +1,79 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yashagw/event-management-api/db/mock"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetEvent(t *testing.T) {
+	event := randomEvent(1)
+
+	testCases := []struct {
+		name       string
+		eventID    int64
+		buildStubs func(provider *mockdb.MockProvider)
+		code       codes.Code
+	}{
+		{
+			name:    "OK",
+			eventID: event.ID,
+			buildStubs: func(provider *mockdb.MockProvider) {
+				// Guests only see published events
+				arg := model.GetEventParams{EventID: event.ID, Published: true}
+				provider.EXPECT().GetEvent(gomock.Any(), arg).Times(1).Return(event, nil)
+			},
+			code: codes.OK,
+		},
+		{
+			name:    "InvalidID",
+			eventID: 0,
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetEvent(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name:    "NotFound",
+			eventID: event.ID,
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetEvent(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrNoRows)
+			},
+			code: codes.NotFound,
+		},
+		{
+			name:    "InternalError",
+			eventID: event.ID,
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetEvent(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			code: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			provider := mockdb.NewMockProvider(ctrl)
+			tc.buildStubs(provider)
+
+			server := newTestServer(t, provider, nil)
+			res, err := server.GetEvent(context.Background(), &pb.GetEventRequest{EventId: tc.eventID})
+			require.Equal(t, tc.code, status.Code(err))
+			if tc.code == codes.OK {
+				require.Equal(t, event.ID, res.GetEvent().GetId())
+				require.Equal(t, pb.EventStatus_EventStatus_Published, res.GetEvent().GetStatus())
+			}
+		})
+	}
+}
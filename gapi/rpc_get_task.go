@@ -0,0 +1,24 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/yashagw/event-management-api/pb"
+)
+
+func (server *Server) GetTask(context context.Context, req *pb.GetTaskRequest) (*pb.GetTaskResponse, error) {
+	if err := validateTaskRequest(req.GetQueue(), req.GetTaskId()); err != nil {
+		return nil, err
+	}
+
+	task, err := server.inspector.GetTask(req.GetQueue(), req.GetTaskId())
+	if err != nil {
+		return nil, taskError(err, "get task")
+	}
+
+	res := &pb.GetTaskResponse{
+		Task: convertTask(task),
+	}
+
+	return res, nil
+}
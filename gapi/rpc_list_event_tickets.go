@@ -0,0 +1,58 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListEventTickets(context context.Context, req *pb.ListEventTicketsRequest) (*pb.ListEventTicketsResponse, error) {
+	payload := authPayloadFromContext(context)
+
+	if req.GetEventId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "event_id must be positive")
+	}
+	if err := validatePage(req.GetLimit(), req.GetOffset()); err != nil {
+		return nil, err
+	}
+
+	event, err := server.provider.GetEvent(context, model.GetEventParams{
+		EventID: req.GetEventId(),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "event not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get event: %v", err)
+	}
+	if event.HostID != payload.UserID {
+		return nil, status.Errorf(codes.PermissionDenied, "%v", model.ErrEventNotOwned)
+	}
+
+	tickets, err := server.provider.ListEventTickets(context, model.ListEventTicketsParams{
+		EventID: event.ID,
+		Limit:   int(req.GetLimit()),
+		Offset:  int(req.GetOffset()),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list tickets: %v", err)
+	}
+
+	stats, err := server.provider.GetEventSalesStats(context, event.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get sales stats: %v", err)
+	}
+
+	res := &pb.ListEventTicketsResponse{
+		Tickets:    convertEventTickets(tickets.Records),
+		NextOffset: int32(tickets.NextOffset),
+		Stats:      convertEventSalesStats(stats),
+	}
+
+	return res, nil
+}
@@ -0,0 +1,121 @@
+package gapi
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yashagw/event-management-api/db/mock"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListEventTickets(t *testing.T) {
+	host := randomUser(model.UserRole_Host)
+	event := &model.Event{ID: 1, HostID: host.ID, TotalTickets: 10, LeftTickets: 8}
+	otherEvent := &model.Event{ID: 2, HostID: host.ID + 1}
+
+	testCases := []struct {
+		name       string
+		req        *pb.ListEventTicketsRequest
+		buildStubs func(provider *mockdb.MockProvider)
+		check      func(t *testing.T, res *pb.ListEventTicketsResponse)
+		code       codes.Code
+	}{
+		{
+			name: "OK",
+			req:  &pb.ListEventTicketsRequest{EventId: event.ID, Limit: 10},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetEvent(gomock.Any(), model.GetEventParams{EventID: event.ID}).Times(1).Return(event, nil)
+				provider.EXPECT().ListEventTickets(gomock.Any(), model.ListEventTicketsParams{EventID: event.ID, Limit: 10}).Times(1).
+					Return(&model.ListEventTicketsResponse{
+						Records: []model.EventTicket{{
+							Ticket:     model.Ticket{ID: 3, EventID: event.ID, Quantity: 2},
+							BuyerName:  "Jane",
+							BuyerEmail: "jane@example.com",
+						}},
+						NextOffset: 1,
+					}, nil)
+				provider.EXPECT().GetEventSalesStats(gomock.Any(), event.ID).Times(1).
+					Return(&model.EventSalesStats{
+						Sold:      2,
+						Remaining: 8,
+						Daily:     []model.DailySales{{Day: time.Now().Truncate(24 * time.Hour), Tickets: 2}},
+					}, nil)
+			},
+			check: func(t *testing.T, res *pb.ListEventTicketsResponse) {
+				require.Len(t, res.GetTickets(), 1)
+				require.Equal(t, "jane@example.com", res.GetTickets()[0].GetBuyerEmail())
+				require.Equal(t, int64(2), res.GetTickets()[0].GetTicket().GetQuantity())
+				require.Equal(t, int32(1), res.GetNextOffset())
+				require.Equal(t, int64(2), res.GetStats().GetSold())
+				require.Equal(t, int64(8), res.GetStats().GetRemaining())
+				require.Len(t, res.GetStats().GetDaily(), 1)
+			},
+			code: codes.OK,
+		},
+		{
+			name: "InvalidEventID",
+			req:  &pb.ListEventTicketsRequest{EventId: 0, Limit: 10},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetEvent(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "InvalidLimit",
+			req:  &pb.ListEventTicketsRequest{EventId: event.ID},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetEvent(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "EventNotFound",
+			req:  &pb.ListEventTicketsRequest{EventId: event.ID, Limit: 10},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetEvent(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrNoRows)
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: "EventOfAnotherHost",
+			req:  &pb.ListEventTicketsRequest{EventId: otherEvent.ID, Limit: 10},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetEvent(gomock.Any(), gomock.Any()).Times(1).Return(otherEvent, nil)
+				provider.EXPECT().ListEventTickets(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.PermissionDenied,
+		},
+		{
+			name: "InternalError",
+			req:  &pb.ListEventTicketsRequest{EventId: event.ID, Limit: 10},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetEvent(gomock.Any(), gomock.Any()).Times(1).Return(event, nil)
+				provider.EXPECT().ListEventTickets(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			code: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			provider := mockdb.NewMockProvider(ctrl)
+			tc.buildStubs(provider)
+
+			server := newTestServer(t, provider, nil)
+			res, err := server.ListEventTickets(newContextWithPayload(t, host), tc.req)
+			require.Equal(t, tc.code, status.Code(err))
+			if tc.check != nil {
+				tc.check(t, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListEvents(context context.Context, req *pb.ListEventsRequest) (*pb.ListEventsResponse, error) {
+	if err := validatePage(req.GetLimit(), req.GetOffset()); err != nil {
+		return nil, err
+	}
+
+	filters, err := eventFilters(req)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := server.provider.ListEvents(context, model.ListEventsParams{
+		Published:    true,
+		EventFilters: filters,
+		Limit:        int(req.GetLimit()),
+		Offset:       int(req.GetOffset()),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list events: %v", err)
+	}
+
+	res := &pb.ListEventsResponse{
+		Events:     convertEvents(events.Records),
+		NextOffset: int32(events.NextOffset),
+	}
+
+	return res, nil
+}
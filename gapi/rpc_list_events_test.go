@@ -0,0 +1,113 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yashagw/event-management-api/db/mock"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListEvents(t *testing.T) {
+	events := []model.Event{*randomEvent(1), *randomEvent(2)}
+
+	testCases := []struct {
+		name       string
+		req        *pb.ListEventsRequest
+		buildStubs func(provider *mockdb.MockProvider)
+		code       codes.Code
+	}{
+		{
+			name: "OK",
+			req: &pb.ListEventsRequest{
+				Limit:  10,
+				Offset: 5,
+				Period: string(model.EventPeriod_Upcoming),
+				Month:  "2026-03",
+				City:   "Pune",
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				arg := model.ListEventsParams{
+					Published: true,
+					EventFilters: model.EventFilters{
+						Period: model.EventPeriod_Upcoming,
+						Month:  time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC),
+						City:   "Pune",
+					},
+					Limit:  10,
+					Offset: 5,
+				}
+				provider.EXPECT().ListEvents(gomock.Any(), arg).Times(1).Return(&model.ListEventsResponse{
+					Records:    events,
+					NextOffset: 7,
+				}, nil)
+			},
+			code: codes.OK,
+		},
+		{
+			name: "InvalidLimit",
+			req:  &pb.ListEventsRequest{Limit: 0},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().ListEvents(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "NegativeOffset",
+			req:  &pb.ListEventsRequest{Limit: 10, Offset: -1},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().ListEvents(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "InvalidPeriod",
+			req:  &pb.ListEventsRequest{Limit: 10, Period: "tomorrow"},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().ListEvents(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "InvalidMonth",
+			req:  &pb.ListEventsRequest{Limit: 10, Month: "03-2026"},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().ListEvents(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "InternalError",
+			req:  &pb.ListEventsRequest{Limit: 10},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().ListEvents(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			code: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			provider := mockdb.NewMockProvider(ctrl)
+			tc.buildStubs(provider)
+
+			server := newTestServer(t, provider, nil)
+			res, err := server.ListEvents(context.Background(), tc.req)
+			require.Equal(t, tc.code, status.Code(err))
+			if tc.code == codes.OK {
+				require.Len(t, res.GetEvents(), len(events))
+				require.Equal(t, int32(7), res.GetNextOffset())
+			}
+		})
+	}
+}
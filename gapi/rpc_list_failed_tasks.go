@@ -0,0 +1,51 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/hibiken/asynq"
+	"github.com/yashagw/event-management-api/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxTaskPageSize is the largest page of tasks that can be asked for
+const maxTaskPageSize = 100
+
+func (server *Server) ListFailedTasks(context context.Context, req *pb.ListFailedTasksRequest) (*pb.ListFailedTasksResponse, error) {
+	if req.GetQueue() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "queue is required")
+	}
+
+	var state asynq.TaskState
+	switch req.GetState() {
+	case asynq.TaskStateRetry.String():
+		state = asynq.TaskStateRetry
+	case asynq.TaskStateArchived.String():
+		state = asynq.TaskStateArchived
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "state must be retry or archived")
+	}
+
+	if req.GetPageSize() < 1 || req.GetPageSize() > maxTaskPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "page_size must be between 1 and %d", maxTaskPageSize)
+	}
+	if req.GetPage() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "page must not be negative")
+	}
+	page := int(req.GetPage())
+	if page == 0 {
+		page = 1
+	}
+
+	tasks, err := server.inspector.ListFailedTasks(req.GetQueue(), state, page, int(req.GetPageSize()))
+	if err != nil {
+		return nil, taskError(err, "list tasks")
+	}
+
+	res := &pb.ListFailedTasksResponse{
+		Tasks: convertTasks(tasks),
+	}
+
+	return res, nil
+}
@@ -0,0 +1,40 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListHostEvents(context context.Context, req *pb.ListHostEventsRequest) (*pb.ListHostEventsResponse, error) {
+	payload := authPayloadFromContext(context)
+
+	if err := validatePage(req.GetLimit(), req.GetOffset()); err != nil {
+		return nil, err
+	}
+
+	filters, err := eventFilters(req)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := server.provider.ListEvents(context, model.ListEventsParams{
+		HostID:       payload.UserID,
+		EventFilters: filters,
+		Limit:        int(req.GetLimit()),
+		Offset:       int(req.GetOffset()),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list events: %v", err)
+	}
+
+	res := &pb.ListHostEventsResponse{
+		Events:     convertEvents(events.Records),
+		NextOffset: int32(events.NextOffset),
+	}
+
+	return res, nil
+}
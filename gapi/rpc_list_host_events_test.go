@@ -0,0 +1,84 @@
+package gapi
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yashagw/event-management-api/db/mock"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListHostEvents(t *testing.T) {
+	host := randomUser(model.UserRole_Host)
+	events := []model.Event{*randomEvent(host.ID)}
+
+	testCases := []struct {
+		name       string
+		req        *pb.ListHostEventsRequest
+		buildStubs func(provider *mockdb.MockProvider)
+		code       codes.Code
+	}{
+		{
+			name: "OK",
+			req:  &pb.ListHostEventsRequest{Limit: 10, MinTicketsLeft: 1},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				// Hosts see their drafts as well
+				arg := model.ListEventsParams{
+					HostID:       host.ID,
+					EventFilters: model.EventFilters{MinTicketsLeft: 1},
+					Limit:        10,
+				}
+				provider.EXPECT().ListEvents(gomock.Any(), arg).Times(1).Return(&model.ListEventsResponse{
+					Records: events,
+				}, nil)
+			},
+			code: codes.OK,
+		},
+		{
+			name: "InvalidLimit",
+			req:  &pb.ListHostEventsRequest{Limit: maxPageLimit + 1},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().ListEvents(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "NegativeDuration",
+			req:  &pb.ListHostEventsRequest{Limit: 10, DurationDays: -1},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().ListEvents(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "InternalError",
+			req:  &pb.ListHostEventsRequest{Limit: 10},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().ListEvents(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			code: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			provider := mockdb.NewMockProvider(ctrl)
+			tc.buildStubs(provider)
+
+			server := newTestServer(t, provider, nil)
+			res, err := server.ListHostEvents(newContextWithPayload(t, host), tc.req)
+			require.Equal(t, tc.code, status.Code(err))
+			if tc.code == codes.OK {
+				require.Len(t, res.GetEvents(), len(events))
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListPendingUserHostRequests(context context.Context, req *pb.ListPendingUserHostRequestsRequest) (*pb.ListPendingUserHostRequestsResponse, error) {
+	if err := validatePage(req.GetLimit(), req.GetOffset()); err != nil {
+		return nil, err
+	}
+
+	response, err := server.provider.ListPendingRequests(context, model.ListPendingRequestsParams{
+		Limit:  int(req.GetLimit()),
+		Offset: int(req.GetOffset()),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list pending requests: %v", err)
+	}
+
+	res := &pb.ListPendingUserHostRequestsResponse{
+		Requests:   convertUserHostRequests(response.Records),
+		NextOffset: int32(response.NextOffset),
+	}
+
+	return res, nil
+}
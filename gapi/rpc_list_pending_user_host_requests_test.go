@@ -0,0 +1,85 @@
+package gapi
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yashagw/event-management-api/db/mock"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"github.com/yashagw/event-management-api/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListPendingUserHostRequests(t *testing.T) {
+	moderator := randomUser(model.UserRole_Moderator)
+	requests := []*model.UserHostRequest{
+		{ID: util.RandomInt(1, 1000), UserID: util.RandomInt(1, 1000)},
+		{ID: util.RandomInt(1, 1000), UserID: util.RandomInt(1, 1000)},
+	}
+
+	testCases := []struct {
+		name       string
+		req        *pb.ListPendingUserHostRequestsRequest
+		buildStubs func(provider *mockdb.MockProvider)
+		code       codes.Code
+	}{
+		{
+			name: "OK",
+			req:  &pb.ListPendingUserHostRequestsRequest{Limit: 2, Offset: 4},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				arg := model.ListPendingRequestsParams{Limit: 2, Offset: 4}
+				provider.EXPECT().ListPendingRequests(gomock.Any(), arg).Times(1).Return(&model.ListPendingRequestsResponse{
+					Records:    requests,
+					NextOffset: 6,
+				}, nil)
+			},
+			code: codes.OK,
+		},
+		{
+			name: "InvalidLimit",
+			req:  &pb.ListPendingUserHostRequestsRequest{Limit: 0},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().ListPendingRequests(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "NegativeOffset",
+			req:  &pb.ListPendingUserHostRequestsRequest{Limit: 2, Offset: -1},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().ListPendingRequests(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "InternalError",
+			req:  &pb.ListPendingUserHostRequestsRequest{Limit: 2},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().ListPendingRequests(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			code: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			provider := mockdb.NewMockProvider(ctrl)
+			tc.buildStubs(provider)
+
+			server := newTestServer(t, provider, nil)
+			res, err := server.ListPendingUserHostRequests(newContextWithPayload(t, moderator), tc.req)
+			require.Equal(t, tc.code, status.Code(err))
+			if tc.code == codes.OK {
+				require.Len(t, res.GetRequests(), len(requests))
+				require.Equal(t, int32(6), res.GetNextOffset())
+			}
+		})
+	}
+}
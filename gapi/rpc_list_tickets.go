@@ -0,0 +1,51 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListTickets(context context.Context, req *pb.ListTicketsRequest) (*pb.ListTicketsResponse, error) {
+	payload := authPayloadFromContext(context)
+
+	if err := validatePage(req.GetLimit(), req.GetOffset()); err != nil {
+		return nil, err
+	}
+
+	period := model.TicketPeriod(req.GetPeriod())
+	switch period {
+	case "", model.TicketPeriod_Upcoming, model.TicketPeriod_Past:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "period must be upcoming or past")
+	}
+
+	sortBy := model.TicketSortBy(req.GetSortBy())
+	switch sortBy {
+	case "", model.TicketSortBy_PurchaseDate, model.TicketSortBy_EventStart:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "sort_by must be purchase_date or event_start")
+	}
+
+	tickets, err := server.provider.ListTickets(context, model.ListTicketsParams{
+		UserID: payload.UserID,
+		Search: req.GetSearch(),
+		Period: period,
+		SortBy: sortBy,
+		Limit:  int(req.GetLimit()),
+		Offset: int(req.GetOffset()),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list tickets: %v", err)
+	}
+
+	res := &pb.ListTicketsResponse{
+		Tickets:    convertUserTickets(tickets.Records),
+		NextOffset: int32(tickets.NextOffset),
+	}
+
+	return res, nil
+}
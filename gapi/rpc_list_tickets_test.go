@@ -0,0 +1,112 @@
+package gapi
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yashagw/event-management-api/db/mock"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"github.com/yashagw/event-management-api/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListTickets(t *testing.T) {
+	user := randomUser(model.UserRole_User)
+	event := randomEvent(util.RandomInt(1, 1000))
+	tickets := []model.UserTicket{
+		{
+			Ticket: model.Ticket{
+				ID:        util.RandomInt(1, 1000),
+				UserID:    user.ID,
+				EventID:   event.ID,
+				Quantity:  1,
+				CreatedAt: time.Now().UTC(),
+			},
+			Event: *event,
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		req        *pb.ListTicketsRequest
+		buildStubs func(provider *mockdb.MockProvider)
+		code       codes.Code
+	}{
+		{
+			name: "OK",
+			req: &pb.ListTicketsRequest{
+				Limit:  10,
+				Search: "pune",
+				Period: string(model.TicketPeriod_Upcoming),
+				SortBy: string(model.TicketSortBy_EventStart),
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				arg := model.ListTicketsParams{
+					UserID: user.ID,
+					Search: "pune",
+					Period: model.TicketPeriod_Upcoming,
+					SortBy: model.TicketSortBy_EventStart,
+					Limit:  10,
+				}
+				provider.EXPECT().ListTickets(gomock.Any(), arg).Times(1).Return(&model.ListTicketsResponse{
+					Records: tickets,
+				}, nil)
+			},
+			code: codes.OK,
+		},
+		{
+			name: "InvalidLimit",
+			req:  &pb.ListTicketsRequest{Limit: 0},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().ListTickets(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "InvalidPeriod",
+			req:  &pb.ListTicketsRequest{Limit: 10, Period: "ongoing"},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().ListTickets(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "InvalidSortBy",
+			req:  &pb.ListTicketsRequest{Limit: 10, SortBy: "price"},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().ListTickets(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "InternalError",
+			req:  &pb.ListTicketsRequest{Limit: 10},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().ListTickets(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			code: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			provider := mockdb.NewMockProvider(ctrl)
+			tc.buildStubs(provider)
+
+			server := newTestServer(t, provider, nil)
+			res, err := server.ListTickets(newContextWithPayload(t, user), tc.req)
+			require.Equal(t, tc.code, status.Code(err))
+			if tc.code == codes.OK {
+				require.Len(t, res.GetTickets(), len(tickets))
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yashagw/event-management-api/db/mock"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"github.com/yashagw/event-management-api/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLoginUser(t *testing.T) {
+	password := util.RandomString(8)
+	hashedPassword, err := util.HashPassword(password)
+	require.NoError(t, err)
+
+	user := randomUser(model.UserRole_User)
+	user.HashedPassword = hashedPassword
+
+	testCases := []struct {
+		name       string
+		req        *pb.LoginUserRequest
+		buildStubs func(provider *mockdb.MockProvider)
+		code       codes.Code
+	}{
+		{
+			name: "OK",
+			req:  &pb.LoginUserRequest{Email: user.Email, Password: password},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(user, nil)
+				provider.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(_ context.Context, arg model.CreateSessionParams) (*model.Session, error) {
+						require.Equal(t, user.ID, arg.UserID)
+						require.NotEmpty(t, arg.RefreshToken)
+						require.NotEmpty(t, arg.AccessTokenID)
+						return &model.Session{
+							ID:           arg.ID,
+							UserID:       arg.UserID,
+							RefreshToken: arg.RefreshToken,
+							ExpiresAt:    arg.ExpiresAt,
+						}, nil
+					})
+			},
+			code: codes.OK,
+		},
+		{
+			name: "NotFound",
+			req:  &pb.LoginUserRequest{Email: user.Email, Password: password},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(nil, sql.ErrNoRows)
+				provider.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: "WrongPassword",
+			req:  &pb.LoginUserRequest{Email: user.Email, Password: "wrong-password"},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(user, nil)
+				provider.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "MissingEmail",
+			req:  &pb.LoginUserRequest{Password: password},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "MissingPassword",
+			req:  &pb.LoginUserRequest{Email: user.Email},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "InternalError",
+			req:  &pb.LoginUserRequest{Email: user.Email, Password: password},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(nil, sql.ErrConnDone)
+				provider.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.Internal,
+		},
+		{
+			name: "CreateSessionError",
+			req:  &pb.LoginUserRequest{Email: user.Email, Password: password},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Times(1).Return(user, nil)
+				provider.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			code: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			provider := mockdb.NewMockProvider(ctrl)
+			tc.buildStubs(provider)
+
+			server := newTestServer(t, provider, nil)
+			res, err := server.LoginUser(context.Background(), tc.req)
+			require.Equal(t, tc.code, status.Code(err))
+			if tc.code == codes.OK {
+				require.NotEmpty(t, res.GetToken())
+				require.NotEmpty(t, res.GetRefreshToken())
+				require.NotEmpty(t, res.GetSessionId())
+				require.Equal(t, user.Email, res.GetUser().GetEmail())
+				require.True(t, res.GetRefreshTokenExpiresAt().AsTime().After(res.GetTokenExpiresAt().AsTime()))
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/yashagw/event-management-api/pb"
+	"github.com/yashagw/event-management-api/revocation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) LogoutAllSessions(context context.Context, req *pb.LogoutAllSessionsRequest) (*pb.LogoutAllSessionsResponse, error) {
+	payload := authPayloadFromContext(context)
+
+	sessions, err := server.provider.BlockUserSessions(context, payload.UserID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to block sessions: %v", err)
+	}
+
+	err = revocation.RevokeSessions(context, server.revocationList, payload, sessions, server.config.AccessTokenDuration)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke tokens: %v", err)
+	}
+
+	res := &pb.LogoutAllSessionsResponse{
+		Message: "logged out of all sessions",
+	}
+
+	return res, nil
+}
@@ -0,0 +1,44 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"github.com/yashagw/event-management-api/revocation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) LogoutUser(context context.Context, req *pb.LogoutUserRequest) (*pb.LogoutUserResponse, error) {
+	payload := authPayloadFromContext(context)
+
+	sessionID, err := uuid.Parse(req.GetSessionId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid session id: %v", err)
+	}
+
+	session, err := server.provider.BlockSession(context, model.BlockSessionParams{
+		ID:     sessionID,
+		UserID: payload.UserID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "session not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to block session: %v", err)
+	}
+
+	err = revocation.RevokeSessions(context, server.revocationList, payload, []model.Session{*session}, server.config.AccessTokenDuration)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke tokens: %v", err)
+	}
+
+	res := &pb.LogoutUserResponse{
+		Message: "logged out",
+	}
+
+	return res, nil
+}
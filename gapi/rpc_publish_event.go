@@ -0,0 +1,68 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) PublishEvent(context context.Context, req *pb.PublishEventRequest) (*pb.PublishEventResponse, error) {
+	event, err := server.updateEventStatus(context, req.GetEventId(), model.EventStatus_Published)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.PublishEventResponse{
+		Event: convertEvent(event),
+	}
+
+	return res, nil
+}
+
+func (server *Server) UnpublishEvent(context context.Context, req *pb.UnpublishEventRequest) (*pb.UnpublishEventResponse, error) {
+	event, err := server.updateEventStatus(context, req.GetEventId(), model.EventStatus_Draft)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.UnpublishEventResponse{
+		Event: convertEvent(event),
+	}
+
+	return res, nil
+}
+
+func (server *Server) updateEventStatus(context context.Context, eventID int64, eventStatus model.EventStatus) (*model.Event, error) {
+	payload := authPayloadFromContext(context)
+
+	if eventID <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "event_id must be positive")
+	}
+
+	event, err := server.provider.UpdateEventStatus(context, model.UpdateEventStatusParams{
+		EventID: eventID,
+		HostID:  payload.UserID,
+		Status:  eventStatus,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, status.Errorf(codes.NotFound, "event not found")
+		case errors.Is(err, model.ErrEventNotOwned):
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		case errors.Is(err, model.ErrInvalidStatusTransition),
+			errors.Is(err, model.ErrEventCancelled),
+			errors.Is(err, model.ErrEventEnded),
+			errors.Is(err, model.ErrEventTicketsSold):
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update event status: %v", err)
+	}
+
+	return event, nil
+}
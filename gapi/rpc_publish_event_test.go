@@ -0,0 +1,141 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yashagw/event-management-api/db/mock"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUpdateEventStatus(t *testing.T) {
+	host := randomUser(model.UserRole_Host)
+	event := randomEvent(host.ID)
+
+	rpcs := []struct {
+		name   string
+		status model.EventStatus
+		call   func(server *Server, ctx context.Context, eventID int64) (*pb.Event, error)
+	}{
+		{
+			name:   "Publish",
+			status: model.EventStatus_Published,
+			call: func(server *Server, ctx context.Context, eventID int64) (*pb.Event, error) {
+				res, err := server.PublishEvent(ctx, &pb.PublishEventRequest{EventId: eventID})
+				return res.GetEvent(), err
+			},
+		},
+		{
+			name:   "Unpublish",
+			status: model.EventStatus_Draft,
+			call: func(server *Server, ctx context.Context, eventID int64) (*pb.Event, error) {
+				res, err := server.UnpublishEvent(ctx, &pb.UnpublishEventRequest{EventId: eventID})
+				return res.GetEvent(), err
+			},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		eventID    int64
+		buildStubs func(provider *mockdb.MockProvider, eventStatus model.EventStatus)
+		code       codes.Code
+	}{
+		{
+			name:    "OK",
+			eventID: event.ID,
+			buildStubs: func(provider *mockdb.MockProvider, eventStatus model.EventStatus) {
+				arg := model.UpdateEventStatusParams{
+					EventID: event.ID,
+					HostID:  host.ID,
+					Status:  eventStatus,
+				}
+				updated := *event
+				updated.Status = eventStatus
+				provider.EXPECT().UpdateEventStatus(gomock.Any(), arg).Times(1).Return(&updated, nil)
+			},
+			code: codes.OK,
+		},
+		{
+			name:    "InvalidID",
+			eventID: -1,
+			buildStubs: func(provider *mockdb.MockProvider, eventStatus model.EventStatus) {
+				provider.EXPECT().UpdateEventStatus(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name:    "NotFound",
+			eventID: event.ID,
+			buildStubs: func(provider *mockdb.MockProvider, eventStatus model.EventStatus) {
+				provider.EXPECT().UpdateEventStatus(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrNoRows)
+			},
+			code: codes.NotFound,
+		},
+		{
+			name:    "NotOwned",
+			eventID: event.ID,
+			buildStubs: func(provider *mockdb.MockProvider, eventStatus model.EventStatus) {
+				provider.EXPECT().UpdateEventStatus(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrEventNotOwned)
+			},
+			code: codes.PermissionDenied,
+		},
+		{
+			name:    "InvalidTransition",
+			eventID: event.ID,
+			buildStubs: func(provider *mockdb.MockProvider, eventStatus model.EventStatus) {
+				provider.EXPECT().UpdateEventStatus(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrInvalidStatusTransition)
+			},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name:    "TicketsSold",
+			eventID: event.ID,
+			buildStubs: func(provider *mockdb.MockProvider, eventStatus model.EventStatus) {
+				provider.EXPECT().UpdateEventStatus(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrEventTicketsSold)
+			},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name:    "Ended",
+			eventID: event.ID,
+			buildStubs: func(provider *mockdb.MockProvider, eventStatus model.EventStatus) {
+				provider.EXPECT().UpdateEventStatus(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrEventEnded)
+			},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name:    "InternalError",
+			eventID: event.ID,
+			buildStubs: func(provider *mockdb.MockProvider, eventStatus model.EventStatus) {
+				provider.EXPECT().UpdateEventStatus(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			code: codes.Internal,
+		},
+	}
+
+	for _, rpc := range rpcs {
+		for _, tc := range testCases {
+			t.Run(rpc.name+tc.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				provider := mockdb.NewMockProvider(ctrl)
+				tc.buildStubs(provider, rpc.status)
+
+				server := newTestServer(t, provider, nil)
+				event, err := rpc.call(server, newContextWithPayload(t, host), tc.eventID)
+				require.Equal(t, tc.code, status.Code(err))
+				if tc.code == codes.OK {
+					require.Equal(t, rpc.status.ToProto(), event.GetStatus())
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"github.com/yashagw/event-management-api/revocation"
+	"github.com/yashagw/event-management-api/token"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (server *Server) RenewAccessToken(context context.Context, req *pb.RenewAccessTokenRequest) (*pb.RenewAccessTokenResponse, error) {
+	refreshPayload, err := server.tokenMaker.VerifyToken(req.GetRefreshToken())
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid refresh token: %v", err)
+	}
+	if err := refreshPayload.CheckType(token.TokenTypeRefresh); err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid refresh token: %v", err)
+	}
+
+	session, err := server.provider.GetSession(context, refreshPayload.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "session not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to find session: %v", err)
+	}
+
+	if session.IsBlocked {
+		return nil, status.Errorf(codes.Unauthenticated, "blocked session")
+	}
+
+	if session.UserID != refreshPayload.UserID {
+		return nil, status.Errorf(codes.Unauthenticated, "incorrect session user")
+	}
+
+	if session.RefreshToken != req.GetRefreshToken() {
+		return nil, status.Errorf(codes.Unauthenticated, "mismatched session token")
+	}
+
+	if time.Now().After(session.ExpiresAt) {
+		return nil, status.Errorf(codes.Unauthenticated, "expired session")
+	}
+
+	// Load the user again so that the new access token carries the current role
+	user, err := server.provider.GetUserByEmail(context, refreshPayload.Username)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.Unauthenticated, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to find user: %v", err)
+	}
+
+	accessToken, accessPayload, err := server.tokenMaker.CreateToken(user.Email, user.ID, user.Role, token.TokenTypeAccess, server.config.AccessTokenDuration)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create access token: %v", err)
+	}
+
+	err = server.provider.UpdateSessionAccessToken(context, model.UpdateSessionAccessTokenParams{
+		ID:            session.ID,
+		AccessTokenID: accessPayload.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update session: %v", err)
+	}
+
+	// The new access token replaces the previous one of the session
+	err = revocation.RevokeSessionAccessToken(context, server.revocationList, *session, server.config.AccessTokenDuration)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke previous access token: %v", err)
+	}
+
+	res := &pb.RenewAccessTokenResponse{
+		Token:          accessToken,
+		TokenExpiresAt: timestamppb.New(accessPayload.ExpiredAt),
+	}
+
+	return res, nil
+}
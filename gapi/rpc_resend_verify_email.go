@@ -0,0 +1,64 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"github.com/yashagw/event-management-api/util"
+	"github.com/yashagw/event-management-api/worker"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ResendVerifyEmail(context context.Context, req *pb.ResendVerifyEmailRequest) (*pb.ResendVerifyEmailResponse, error) {
+	payload := authPayloadFromContext(context)
+
+	secretCode, err := util.RandomSecret(32)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate secret code: %v", err)
+	}
+
+	_, err = server.provider.ResendVerifyEmailTx(context, model.ResendVerifyEmailTxParams{
+		UserID:     payload.UserID,
+		SecretCode: secretCode,
+		Interval:   server.config.VerifyEmailInterval,
+		AfterCreate: func(verifyEmail *model.VerifyEmail) ([]model.CreateOutboxMessageParams, error) {
+			taskPayload := &worker.PayloadSendEmailVerify{
+				Email:         verifyEmail.Email,
+				VerifyEmailID: verifyEmail.ID,
+			}
+			opts := []asynq.Option{
+				asynq.MaxRetry(3),
+				asynq.Timeout(10 * time.Second),
+				asynq.Queue(worker.QueueCritical),
+			}
+			message, err := worker.NewOutboxMessage(worker.TaskSendVerifyEmail, taskPayload, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return []model.CreateOutboxMessageParams{message}, nil
+		},
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		case errors.Is(err, model.ErrEmailAlreadyVerified):
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		case errors.Is(err, model.ErrVerifyEmailTooSoon):
+			return nil, status.Errorf(codes.ResourceExhausted, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to resend verify email: %v", err)
+	}
+
+	res := &pb.ResendVerifyEmailResponse{
+		Message: "verification email sent",
+	}
+
+	return res, nil
+}
@@ -0,0 +1,98 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yashagw/event-management-api/db/mock"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"github.com/yashagw/event-management-api/worker"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestResendVerifyEmail(t *testing.T) {
+	user := randomUser(model.UserRole_User)
+	user.IsEmailVerified = false
+
+	testCases := []struct {
+		name       string
+		buildStubs func(provider *mockdb.MockProvider)
+		code       codes.Code
+	}{
+		{
+			name: "OK",
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().ResendVerifyEmailTx(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(_ context.Context, arg model.ResendVerifyEmailTxParams) (*model.VerifyEmail, error) {
+						require.Equal(t, user.ID, arg.UserID)
+						require.NotEmpty(t, arg.SecretCode)
+						require.Equal(t, time.Minute, arg.Interval)
+
+						verifyEmail := &model.VerifyEmail{ID: 1, UserID: user.ID, Email: user.Email, SecretCode: arg.SecretCode}
+						messages, err := arg.AfterCreate(verifyEmail)
+						require.NoError(t, err)
+						require.Len(t, messages, 1)
+
+						var payload worker.PayloadSendEmailVerify
+						require.NoError(t, json.Unmarshal(messages[0].Payload, &payload))
+						require.Equal(t, verifyEmail.ID, payload.VerifyEmailID)
+
+						return verifyEmail, nil
+					})
+			},
+			code: codes.OK,
+		},
+		{
+			name: "AlreadyVerified",
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().ResendVerifyEmailTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrEmailAlreadyVerified)
+			},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name: "TooSoon",
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().ResendVerifyEmailTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrVerifyEmailTooSoon)
+			},
+			code: codes.ResourceExhausted,
+		},
+		{
+			name: "UserNotFound",
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().ResendVerifyEmailTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrNoRows)
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().ResendVerifyEmailTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			code: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			provider := mockdb.NewMockProvider(ctrl)
+			tc.buildStubs(provider)
+
+			server := newTestServer(t, provider, nil)
+			res, err := server.ResendVerifyEmail(newContextWithPayload(t, user), &pb.ResendVerifyEmailRequest{})
+			require.Equal(t, tc.code, status.Code(err))
+			if tc.code == codes.OK {
+				require.NotEmpty(t, res.GetMessage())
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/yashagw/event-management-api/pb"
+)
+
+func (server *Server) RetryTask(context context.Context, req *pb.RetryTaskRequest) (*pb.RetryTaskResponse, error) {
+	if err := validateTaskRequest(req.GetQueue(), req.GetTaskId()); err != nil {
+		return nil, err
+	}
+
+	err := server.inspector.RetryTask(req.GetQueue(), req.GetTaskId())
+	if err != nil {
+		return nil, taskError(err, "retry task")
+	}
+
+	res := &pb.RetryTaskResponse{
+		Message: "task queued",
+	}
+
+	return res, nil
+}
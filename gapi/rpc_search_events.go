@@ -0,0 +1,76 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) SearchEvents(context context.Context, req *pb.SearchEventsRequest) (*pb.SearchEventsResponse, error) {
+	if req.GetQ() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "q is required")
+	}
+	if err := validatePage(req.GetLimit(), req.GetOffset()); err != nil {
+		return nil, err
+	}
+
+	filters, err := eventFilters(req)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := server.provider.SearchEvents(context, model.SearchEventsParams{
+		Query:        req.GetQ(),
+		Published:    true,
+		EventFilters: filters,
+		Limit:        int(req.GetLimit()),
+		Offset:       int(req.GetOffset()),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search events: %v", err)
+	}
+
+	res := &pb.SearchEventsResponse{
+		Results:    convertEventSearchResults(events.Records),
+		NextOffset: int32(events.NextOffset),
+	}
+
+	return res, nil
+}
+
+func (server *Server) SearchHostEvents(context context.Context, req *pb.SearchHostEventsRequest) (*pb.SearchHostEventsResponse, error) {
+	payload := authPayloadFromContext(context)
+
+	if req.GetQ() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "q is required")
+	}
+	if err := validatePage(req.GetLimit(), req.GetOffset()); err != nil {
+		return nil, err
+	}
+
+	filters, err := eventFilters(req)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := server.provider.SearchEvents(context, model.SearchEventsParams{
+		Query:        req.GetQ(),
+		HostID:       payload.UserID,
+		EventFilters: filters,
+		Limit:        int(req.GetLimit()),
+		Offset:       int(req.GetOffset()),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search events: %v", err)
+	}
+
+	res := &pb.SearchHostEventsResponse{
+		Results:    convertEventSearchResults(events.Records),
+		NextOffset: int32(events.NextOffset),
+	}
+
+	return res, nil
+}
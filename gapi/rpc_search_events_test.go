@@ -0,0 +1,120 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yashagw/event-management-api/db/mock"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSearchEvents(t *testing.T) {
+	host := randomUser(model.UserRole_Host)
+	results := []model.EventSearchResult{
+		{Event: *randomEvent(host.ID), Rank: 0.5, Headline: "<b>rock</b> concert"},
+	}
+
+	rpcs := []struct {
+		name string
+		// published and hostID are the params the rpc searches with
+		published bool
+		hostID    int64
+		call      func(server *Server, q string, limit int32) ([]*pb.EventSearchResult, error)
+	}{
+		{
+			name:      "Public",
+			published: true,
+			call: func(server *Server, q string, limit int32) ([]*pb.EventSearchResult, error) {
+				res, err := server.SearchEvents(context.Background(), &pb.SearchEventsRequest{Q: q, Limit: limit})
+				return res.GetResults(), err
+			},
+		},
+		{
+			name:   "Host",
+			hostID: host.ID,
+			call: func(server *Server, q string, limit int32) ([]*pb.EventSearchResult, error) {
+				res, err := server.SearchHostEvents(newContextWithPayload(t, host), &pb.SearchHostEventsRequest{Q: q, Limit: limit})
+				return res.GetResults(), err
+			},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		q          string
+		limit      int32
+		buildStubs func(provider *mockdb.MockProvider, published bool, hostID int64)
+		code       codes.Code
+	}{
+		{
+			name:  "OK",
+			q:     "rock",
+			limit: 10,
+			buildStubs: func(provider *mockdb.MockProvider, published bool, hostID int64) {
+				arg := model.SearchEventsParams{
+					Query:     "rock",
+					HostID:    hostID,
+					Published: published,
+					Limit:     10,
+				}
+				provider.EXPECT().SearchEvents(gomock.Any(), arg).Times(1).Return(&model.SearchEventsResponse{
+					Records: results,
+				}, nil)
+			},
+			code: codes.OK,
+		},
+		{
+			name:  "EmptyQuery",
+			q:     "",
+			limit: 10,
+			buildStubs: func(provider *mockdb.MockProvider, published bool, hostID int64) {
+				provider.EXPECT().SearchEvents(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name:  "InvalidLimit",
+			q:     "rock",
+			limit: 0,
+			buildStubs: func(provider *mockdb.MockProvider, published bool, hostID int64) {
+				provider.EXPECT().SearchEvents(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name:  "InternalError",
+			q:     "rock",
+			limit: 10,
+			buildStubs: func(provider *mockdb.MockProvider, published bool, hostID int64) {
+				provider.EXPECT().SearchEvents(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			code: codes.Internal,
+		},
+	}
+
+	for _, rpc := range rpcs {
+		for _, tc := range testCases {
+			t.Run(rpc.name+tc.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				provider := mockdb.NewMockProvider(ctrl)
+				tc.buildStubs(provider, rpc.published, rpc.hostID)
+
+				server := newTestServer(t, provider, nil)
+				res, err := rpc.call(server, tc.q, tc.limit)
+				require.Equal(t, tc.code, status.Code(err))
+				if tc.code == codes.OK {
+					require.Len(t, res, len(results))
+					require.Equal(t, results[0].Headline, res[0].GetHeadline())
+				}
+			})
+		}
+	}
+}
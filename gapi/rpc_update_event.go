@@ -0,0 +1,71 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"github.com/yashagw/event-management-api/worker"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) UpdateEvent(context context.Context, req *pb.UpdateEventRequest) (*pb.UpdateEventResponse, error) {
+	payload := authPayloadFromContext(context)
+
+	if req.GetEventId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "event_id must be positive")
+	}
+	if req.Name != nil && req.GetName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name must not be empty")
+	}
+	if req.Location != nil && req.GetLocation() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "location must not be empty")
+	}
+	if req.TotalTickets != nil && req.GetTotalTickets() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "total_tickets must be positive")
+	}
+
+	arg := model.UpdateEventParams{
+		EventID:      req.GetEventId(),
+		HostID:       payload.UserID,
+		Name:         req.Name,
+		Description:  req.Description,
+		Location:     req.Location,
+		TotalTickets: req.TotalTickets,
+	}
+	if req.StartDate != nil {
+		startDate := req.GetStartDate().AsTime()
+		arg.StartDate = &startDate
+	}
+	if req.EndDate != nil {
+		endDate := req.GetEndDate().AsTime()
+		arg.EndDate = &endDate
+	}
+
+	event, err := server.provider.UpdateEventTx(context, model.UpdateEventTxParams{
+		UpdateEventParams: arg,
+		AfterUpdate:       worker.NewEventChangedMessages,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, status.Errorf(codes.NotFound, "event not found")
+		case errors.Is(err, model.ErrEventNotOwned):
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		case errors.Is(err, model.ErrEventTicketsSold), errors.Is(err, model.ErrEventCancelled), errors.Is(err, model.ErrEventEnded):
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		case errors.Is(err, model.ErrInvalidEventDates):
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update event: %v", err)
+	}
+
+	res := &pb.UpdateEventResponse{
+		Event: convertEvent(event),
+	}
+
+	return res, nil
+}
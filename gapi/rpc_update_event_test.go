@@ -0,0 +1,159 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yashagw/event-management-api/db/mock"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"github.com/yashagw/event-management-api/worker"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestUpdateEvent(t *testing.T) {
+	host := randomUser(model.UserRole_Host)
+	event := randomEvent(host.ID)
+
+	testCases := []struct {
+		name       string
+		req        *pb.UpdateEventRequest
+		buildStubs func(provider *mockdb.MockProvider)
+		code       codes.Code
+	}{
+		{
+			name: "OK",
+			req: &pb.UpdateEventRequest{
+				EventId:   event.ID,
+				Name:      proto.String(event.Name),
+				StartDate: timestamppb.New(event.StartDate),
+			},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().
+					UpdateEventTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg model.UpdateEventTxParams) (*model.Event, error) {
+						require.Equal(t, event.ID, arg.EventID)
+						require.Equal(t, host.ID, arg.HostID)
+						require.Equal(t, event.Name, *arg.Name)
+						require.True(t, event.StartDate.Equal(*arg.StartDate))
+						// Fields left out of the request are kept
+						require.Nil(t, arg.Location)
+						require.Nil(t, arg.TotalTickets)
+						require.Nil(t, arg.EndDate)
+
+						messages, err := arg.AfterUpdate(event, []int64{1})
+						require.NoError(t, err)
+						require.Len(t, messages, 1)
+						require.Equal(t, worker.TaskSendEventChanged, messages[0].TaskType)
+
+						return event, nil
+					})
+			},
+			code: codes.OK,
+		},
+		{
+			name: "InvalidID",
+			req:  &pb.UpdateEventRequest{EventId: 0},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().UpdateEventTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "EmptyName",
+			req:  &pb.UpdateEventRequest{EventId: event.ID, Name: proto.String("")},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().UpdateEventTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "EmptyLocation",
+			req:  &pb.UpdateEventRequest{EventId: event.ID, Location: proto.String("")},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().UpdateEventTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "InvalidTotalTickets",
+			req:  &pb.UpdateEventRequest{EventId: event.ID, TotalTickets: proto.Int64(0)},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().UpdateEventTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "NotFound",
+			req:  &pb.UpdateEventRequest{EventId: event.ID},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().UpdateEventTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrNoRows)
+			},
+			code: codes.NotFound,
+		},
+		{
+			name: "NotOwned",
+			req:  &pb.UpdateEventRequest{EventId: event.ID},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().UpdateEventTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrEventNotOwned)
+			},
+			code: codes.PermissionDenied,
+		},
+		{
+			name: "TicketsSold",
+			req:  &pb.UpdateEventRequest{EventId: event.ID, Name: proto.String(event.Name)},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().UpdateEventTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrEventTicketsSold)
+			},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name: "Cancelled",
+			req:  &pb.UpdateEventRequest{EventId: event.ID},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().UpdateEventTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrEventCancelled)
+			},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name: "InvalidDates",
+			req:  &pb.UpdateEventRequest{EventId: event.ID, EndDate: timestamppb.New(event.StartDate.Add(-1))},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().UpdateEventTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, model.ErrInvalidEventDates)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "InternalError",
+			req:  &pb.UpdateEventRequest{EventId: event.ID},
+			buildStubs: func(provider *mockdb.MockProvider) {
+				provider.EXPECT().UpdateEventTx(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			code: codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			provider := mockdb.NewMockProvider(ctrl)
+			tc.buildStubs(provider)
+
+			server := newTestServer(t, provider, nil)
+			res, err := server.UpdateEvent(newContextWithPayload(t, host), tc.req)
+			require.Equal(t, tc.code, status.Code(err))
+			if tc.code == codes.OK {
+				require.Equal(t, event.ID, res.GetEvent().GetId())
+			}
+		})
+	}
+}
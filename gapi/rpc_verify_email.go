@@ -0,0 +1,34 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) VerifyEmail(context context.Context, req *pb.VerifyEmailRequest) (*pb.VerifyEmailResponse, error) {
+	if req.GetEmailId() <= 0 || req.GetSecretCode() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "email_id and secret_code are required")
+	}
+
+	user, err := server.provider.VerifyEmail(context, model.VerifyEmailParams{
+		EmailID:    req.GetEmailId(),
+		SecretCode: req.GetSecretCode(),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid or expired code")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to verify email: %v", err)
+	}
+
+	res := &pb.VerifyEmailResponse{
+		IsVerified: user.IsEmailVerified,
+	}
+
+	return res, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	_ "github.com/yashagw/event-management-api/docs"
 	"github.com/yashagw/event-management-api/pb"
+	"github.com/yashagw/event-management-api/revocation"
 	"github.com/yashagw/event-management-api/worker"
 
 	"github.com/yashagw/event-management-api/db"
@@ -15,24 +16,34 @@ import (
 // Server will serve HTTP requests for our event service.
 type Server struct {
 	pb.UnimplementedEventManagementServer
-	provider    db.Provider
-	config      util.Config
-	tokenMaker  token.Maker
-	distributor worker.TaskDistributor
+	provider       db.Provider
+	config         util.Config
+	tokenMaker     token.Maker
+	distributor    worker.TaskDistributor
+	inspector      worker.TaskInspector
+	revocationList revocation.List
 }
 
 // NewServer creates a new gRPC server
-func NewServer(config util.Config, provider db.Provider, distributor worker.TaskDistributor) (*Server, error) {
-	tokenMaker, err := token.NewPasetoMaker(config.TokenSymmetricKey)
+func NewServer(
+	config util.Config,
+	provider db.Provider,
+	distributor worker.TaskDistributor,
+	inspector worker.TaskInspector,
+	revocationList revocation.List,
+) (*Server, error) {
+	tokenMaker, err := token.NewMaker(config)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create token maker: %w", err)
 	}
 
 	server := &Server{
-		provider:    provider,
-		config:      config,
-		tokenMaker:  tokenMaker,
-		distributor: distributor,
+		provider:       provider,
+		config:         config,
+		tokenMaker:     tokenMaker,
+		distributor:    distributor,
+		inspector:      inspector,
+		revocationList: revocationList,
 	}
 
 	return server, nil
@@ -0,0 +1,21 @@
+package gapi
+
+import (
+	"errors"
+
+	"github.com/hibiken/asynq"
+	"github.com/yashagw/event-management-api/worker"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// taskError maps the errors of a worker.TaskInspector to a status
+func taskError(err error, action string) error {
+	switch {
+	case errors.Is(err, asynq.ErrQueueNotFound), errors.Is(err, asynq.ErrTaskNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, worker.ErrTaskNotFailed):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+}
@@ -0,0 +1,205 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yashagw/event-management-api/db/mock"
+	"github.com/yashagw/event-management-api/pb"
+	"github.com/yashagw/event-management-api/worker"
+	mockwk "github.com/yashagw/event-management-api/worker/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func randomTaskInfo(state asynq.TaskState) *asynq.TaskInfo {
+	return &asynq.TaskInfo{
+		ID:           "task-id",
+		Queue:        worker.QueueCritical,
+		Type:         worker.TaskSendVerifyEmail,
+		Payload:      []byte(`{"email":"jane@example.com"}`),
+		State:        state,
+		MaxRetry:     3,
+		Retried:      3,
+		LastErr:      "smtp is down",
+		LastFailedAt: time.Now().UTC().Truncate(time.Second),
+	}
+}
+
+func TestListFailedTasks(t *testing.T) {
+	task := randomTaskInfo(asynq.TaskStateArchived)
+
+	testCases := []struct {
+		name       string
+		req        *pb.ListFailedTasksRequest
+		buildStubs func(inspector *mockwk.MockTaskInspector)
+		check      func(t *testing.T, res *pb.ListFailedTasksResponse)
+		code       codes.Code
+	}{
+		{
+			name: "OK",
+			req:  &pb.ListFailedTasksRequest{Queue: worker.QueueCritical, State: "archived", PageSize: 10},
+			buildStubs: func(inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().ListFailedTasks(worker.QueueCritical, asynq.TaskStateArchived, 1, 10).Times(1).Return([]*asynq.TaskInfo{task}, nil)
+			},
+			check: func(t *testing.T, res *pb.ListFailedTasksResponse) {
+				require.Len(t, res.GetTasks(), 1)
+				got := res.GetTasks()[0]
+				require.Equal(t, task.ID, got.GetId())
+				require.Equal(t, "archived", got.GetState())
+				require.Equal(t, "smtp is down", got.GetLastError())
+				require.Equal(t, "jane@example.com", got.GetPayload().GetFields()["email"].GetStringValue())
+				require.Equal(t, task.LastFailedAt, got.GetLastFailedAt().AsTime())
+				require.Nil(t, got.GetNextProcessAt())
+			},
+			code: codes.OK,
+		},
+		{
+			name: "InvalidState",
+			req:  &pb.ListFailedTasksRequest{Queue: worker.QueueCritical, State: "pending", PageSize: 10},
+			buildStubs: func(inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().ListFailedTasks(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "InvalidPageSize",
+			req:  &pb.ListFailedTasksRequest{Queue: worker.QueueCritical, State: "retry", PageSize: 101},
+			buildStubs: func(inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().ListFailedTasks(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "QueueNotFound",
+			req:  &pb.ListFailedTasksRequest{Queue: "unknown", State: "retry", Page: 2, PageSize: 10},
+			buildStubs: func(inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().ListFailedTasks("unknown", asynq.TaskStateRetry, 2, 10).Times(1).Return(nil, asynq.ErrQueueNotFound)
+			},
+			code: codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			inspector := mockwk.NewMockTaskInspector(ctrl)
+			tc.buildStubs(inspector)
+
+			server := newTestServerWithInspector(t, mockdb.NewMockProvider(ctrl), inspector)
+			res, err := server.ListFailedTasks(context.Background(), tc.req)
+			require.Equal(t, tc.code, status.Code(err))
+			if tc.check != nil {
+				tc.check(t, res)
+			}
+		})
+	}
+}
+
+func TestTaskActions(t *testing.T) {
+	task := randomTaskInfo(asynq.TaskStateRetry)
+
+	call := func(server *Server, method string, queue, taskID string) error {
+		var err error
+		switch method {
+		case "GetTask":
+			_, err = server.GetTask(context.Background(), &pb.GetTaskRequest{Queue: queue, TaskId: taskID})
+		case "RetryTask":
+			_, err = server.RetryTask(context.Background(), &pb.RetryTaskRequest{Queue: queue, TaskId: taskID})
+		case "DeleteTask":
+			_, err = server.DeleteTask(context.Background(), &pb.DeleteTaskRequest{Queue: queue, TaskId: taskID})
+		}
+		return err
+	}
+
+	testCases := []struct {
+		name       string
+		method     string
+		taskID     string
+		buildStubs func(inspector *mockwk.MockTaskInspector)
+		code       codes.Code
+	}{
+		{
+			name:   "GetOK",
+			method: "GetTask",
+			taskID: task.ID,
+			buildStubs: func(inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().GetTask(task.Queue, task.ID).Times(1).Return(task, nil)
+			},
+			code: codes.OK,
+		},
+		{
+			name:   "GetNotFound",
+			method: "GetTask",
+			taskID: task.ID,
+			buildStubs: func(inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().GetTask(task.Queue, task.ID).Times(1).Return(nil, asynq.ErrTaskNotFound)
+			},
+			code: codes.NotFound,
+		},
+		{
+			name:   "GetMissingTaskID",
+			method: "GetTask",
+			buildStubs: func(inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().GetTask(gomock.Any(), gomock.Any()).Times(0)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name:   "RetryOK",
+			method: "RetryTask",
+			taskID: task.ID,
+			buildStubs: func(inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().RetryTask(task.Queue, task.ID).Times(1).Return(nil)
+			},
+			code: codes.OK,
+		},
+		{
+			name:   "RetryNotFailed",
+			method: "RetryTask",
+			taskID: task.ID,
+			buildStubs: func(inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().RetryTask(task.Queue, task.ID).Times(1).Return(worker.ErrTaskNotFailed)
+			},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name:   "DeleteOK",
+			method: "DeleteTask",
+			taskID: task.ID,
+			buildStubs: func(inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().DeleteTask(task.Queue, task.ID).Times(1).Return(nil)
+			},
+			code: codes.OK,
+		},
+		{
+			name:   "DeleteNotFailed",
+			method: "DeleteTask",
+			taskID: task.ID,
+			buildStubs: func(inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().DeleteTask(task.Queue, task.ID).Times(1).Return(worker.ErrTaskNotFailed)
+			},
+			code: codes.FailedPrecondition,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			inspector := mockwk.NewMockTaskInspector(ctrl)
+			tc.buildStubs(inspector)
+
+			server := newTestServerWithInspector(t, mockdb.NewMockProvider(ctrl), inspector)
+			err := call(server, tc.method, task.Queue, tc.taskID)
+			require.Equal(t, tc.code, status.Code(err))
+		})
+	}
+}
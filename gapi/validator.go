@@ -0,0 +1,70 @@
+package gapi
+
+import (
+	"time"
+
+	"github.com/yashagw/event-management-api/db/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxPageLimit = 1000
+
+// validatePage checks limit and offset the same way the REST binding does
+func validatePage(limit, offset int32) error {
+	if limit < 1 || limit > maxPageLimit {
+		return status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d", maxPageLimit)
+	}
+	if offset < 0 {
+		return status.Errorf(codes.InvalidArgument, "offset must not be negative")
+	}
+	return nil
+}
+
+// eventFiltersRequest is implemented by the requests that filter events
+type eventFiltersRequest interface {
+	GetPeriod() string
+	GetDurationDays() int32
+	GetMonth() string
+	GetCity() string
+	GetMinTicketsLeft() int64
+}
+
+// eventFilters validates the filters of req the same way the REST binding does
+func eventFilters(req eventFiltersRequest) (model.EventFilters, error) {
+	filters := model.EventFilters{
+		Period:         model.EventPeriod(req.GetPeriod()),
+		DurationDays:   int(req.GetDurationDays()),
+		City:           req.GetCity(),
+		MinTicketsLeft: req.GetMinTicketsLeft(),
+	}
+
+	switch filters.Period {
+	case "", model.EventPeriod_Ongoing, model.EventPeriod_Upcoming, model.EventPeriod_Past:
+	default:
+		return filters, status.Errorf(codes.InvalidArgument, "period must be ongoing, upcoming or past")
+	}
+	if filters.DurationDays < 0 {
+		return filters, status.Errorf(codes.InvalidArgument, "duration_days must not be negative")
+	}
+	if filters.MinTicketsLeft < 0 {
+		return filters, status.Errorf(codes.InvalidArgument, "min_tickets_left must not be negative")
+	}
+	if req.GetMonth() != "" {
+		month, err := time.Parse("2006-01", req.GetMonth())
+		if err != nil {
+			return filters, status.Errorf(codes.InvalidArgument, "month must be formatted as YYYY-MM")
+		}
+		filters.Month = month
+	}
+
+	return filters, nil
+}
+
+// validateTaskRequest checks the queue and the id of a task
+func validateTaskRequest(queue, taskID string) error {
+	if queue == "" || taskID == "" {
+		return status.Errorf(codes.InvalidArgument, "queue and task_id are required")
+	}
+	return nil
+}
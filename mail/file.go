@@ -0,0 +1,73 @@
+package mail
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// FileSender writes emails as .eml files to a maildir instead of sending them,
+// so emails can be read on machines without network access.
+// Each email is written to dir/tmp and then moved to dir/new, so readers never
+// see a partially written file.
+type FileSender struct {
+	from Address
+	dir  string
+}
+
+func NewFileSender(from Address, dir string) (EmailSender, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("email file directory must not be empty")
+	}
+
+	for _, sub := range []string{"tmp", "new", "cur"} {
+		err := os.MkdirAll(filepath.Join(dir, sub), 0o755)
+		if err != nil {
+			return nil, fmt.Errorf("could not create maildir: %w", err)
+		}
+	}
+
+	return &FileSender{
+		from: from,
+		dir:  dir,
+	}, nil
+}
+
+func (sender *FileSender) SendEmail(email *Email) error {
+	now := time.Now()
+	message, err := email.Bytes(sender.from, now)
+	if err != nil {
+		return fmt.Errorf("could not build email: %w", err)
+	}
+
+	name, err := newFileName(now)
+	if err != nil {
+		return err
+	}
+
+	tmpPath := filepath.Join(sender.dir, "tmp", name)
+	err = os.WriteFile(tmpPath, message, 0o644)
+	if err != nil {
+		return fmt.Errorf("could not write email: %w", err)
+	}
+
+	err = os.Rename(tmpPath, filepath.Join(sender.dir, "new", name))
+	if err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("could not deliver email: %w", err)
+	}
+
+	return nil
+}
+
+func newFileName(now time.Time) (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%d.%s.eml", now.UnixNano(), hex.EncodeToString(b)), nil
+}
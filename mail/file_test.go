@@ -0,0 +1,41 @@
+package mail
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileSender(t *testing.T) {
+	dir := t.TempDir()
+
+	sender, err := NewFileSender(testFrom, dir)
+	require.NoError(t, err)
+
+	email := &Email{
+		To:       []string{"user@example.com"},
+		Subject:  "Hello",
+		TextBody: "Hello there",
+	}
+	require.NoError(t, sender.SendEmail(email))
+	require.NoError(t, sender.SendEmail(email))
+
+	files, err := os.ReadDir(filepath.Join(dir, "new"))
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	require.True(t, strings.HasSuffix(files[0].Name(), ".eml"))
+
+	data, err := os.ReadFile(filepath.Join(dir, "new", files[0].Name()))
+	require.NoError(t, err)
+	require.Contains(t, string(data), "Subject: Hello")
+
+	tmpFiles, err := os.ReadDir(filepath.Join(dir, "tmp"))
+	require.NoError(t, err)
+	require.Empty(t, tmpFiles)
+
+	// Invalid emails are not written
+	require.Error(t, sender.SendEmail(&Email{Subject: "no recipients", TextBody: "body"}))
+}
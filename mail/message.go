@@ -0,0 +1,236 @@
+package mail
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Address is an email address with an optional display name
+type Address struct {
+	Name  string
+	Email string
+}
+
+func (address Address) String() string {
+	return (&mail.Address{Name: address.Name, Address: address.Email}).String()
+}
+
+// Attachment is a file attached to an email
+type Attachment struct {
+	Filename string
+	// ContentType is guessed from the file name when empty
+	ContentType string
+	Data        []byte
+}
+
+// Email is a message with a plain text body, an HTML body or both
+type Email struct {
+	To          []string
+	Cc          []string
+	Bcc         []string
+	Subject     string
+	TextBody    string
+	HTMLBody    string
+	Attachments []Attachment
+}
+
+// Recipients returns every address the email has to be delivered to
+func (email *Email) Recipients() []string {
+	recipients := make([]string, 0, len(email.To)+len(email.Cc)+len(email.Bcc))
+	recipients = append(recipients, email.To...)
+	recipients = append(recipients, email.Cc...)
+	recipients = append(recipients, email.Bcc...)
+	return recipients
+}
+
+func (email *Email) validate() error {
+	if len(email.Recipients()) == 0 {
+		return errors.New("email has no recipients")
+	}
+	if email.TextBody == "" && email.HTMLBody == "" {
+		return errors.New("email has no body")
+	}
+	return nil
+}
+
+// Bytes encodes the email as a MIME message sent by from.
+// Bcc recipients are not part of the message, they only receive it.
+func (email *Email) Bytes(from Address, date time.Time) ([]byte, error) {
+	if err := email.validate(); err != nil {
+		return nil, err
+	}
+
+	messageID, err := newMessageID(from.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	writeHeader(&buf, "From", from.String())
+	writeHeader(&buf, "To", strings.Join(email.To, ", "))
+	if len(email.Cc) > 0 {
+		writeHeader(&buf, "Cc", strings.Join(email.Cc, ", "))
+	}
+	writeHeader(&buf, "Subject", mime.QEncoding.Encode("utf-8", email.Subject))
+	writeHeader(&buf, "Date", date.Format(time.RFC1123Z))
+	writeHeader(&buf, "Message-ID", messageID)
+	writeHeader(&buf, "MIME-Version", "1.0")
+
+	header, content, err := email.bodyPart()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(email.Attachments) == 0 {
+		writeMIMEHeader(&buf, header)
+		buf.WriteString("\r\n")
+		buf.Write(content)
+		return buf.Bytes(), nil
+	}
+
+	mixed := multipart.NewWriter(&buf)
+	writeHeader(&buf, "Content-Type", mime.FormatMediaType("multipart/mixed", map[string]string{"boundary": mixed.Boundary()}))
+	buf.WriteString("\r\n")
+
+	part, err := mixed.CreatePart(header)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := part.Write(content); err != nil {
+		return nil, err
+	}
+
+	for _, attachment := range email.Attachments {
+		if err := writeAttachment(mixed, attachment); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := mixed.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// bodyPart returns the headers and the content of the text and/or HTML body
+func (email *Email) bodyPart() (textproto.MIMEHeader, []byte, error) {
+	if email.TextBody == "" || email.HTMLBody == "" {
+		contentType, body := "text/plain", email.TextBody
+		if email.HTMLBody != "" {
+			contentType, body = "text/html", email.HTMLBody
+		}
+		return textPart(contentType, body)
+	}
+
+	var content bytes.Buffer
+	alternative := multipart.NewWriter(&content)
+	for _, body := range []struct{ contentType, body string }{
+		{"text/plain", email.TextBody},
+		{"text/html", email.HTMLBody},
+	} {
+		header, partContent, err := textPart(body.contentType, body.body)
+		if err != nil {
+			return nil, nil, err
+		}
+		part, err := alternative.CreatePart(header)
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, err := part.Write(partContent); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := alternative.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Type", mime.FormatMediaType("multipart/alternative", map[string]string{"boundary": alternative.Boundary()}))
+	return header, content.Bytes(), nil
+}
+
+func textPart(contentType string, body string) (textproto.MIMEHeader, []byte, error) {
+	var content bytes.Buffer
+	qp := quotedprintable.NewWriter(&content)
+	if _, err := qp.Write([]byte(body)); err != nil {
+		return nil, nil, err
+	}
+	if err := qp.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Type", contentType+"; charset=utf-8")
+	header.Set("Content-Transfer-Encoding", "quoted-printable")
+	return header, content.Bytes(), nil
+}
+
+func writeAttachment(writer *multipart.Writer, attachment Attachment) error {
+	contentType := attachment.ContentType
+	if contentType == "" {
+		contentType = mime.TypeByExtension(filepath.Ext(attachment.Filename))
+	}
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Type", contentType)
+	header.Set("Content-Transfer-Encoding", "base64")
+	header.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename}))
+
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return err
+	}
+
+	// Lines of base64 must not be longer than 76 characters
+	encoded := base64.StdEncoding.EncodeToString(attachment.Data)
+	for len(encoded) > 76 {
+		if _, err := fmt.Fprintf(part, "%s\r\n", encoded[:76]); err != nil {
+			return err
+		}
+		encoded = encoded[76:]
+	}
+	_, err = fmt.Fprintf(part, "%s\r\n", encoded)
+	return err
+}
+
+func writeHeader(buf *bytes.Buffer, key string, value string) {
+	fmt.Fprintf(buf, "%s: %s\r\n", key, value)
+}
+
+func writeMIMEHeader(buf *bytes.Buffer, header textproto.MIMEHeader) {
+	for _, key := range []string{"Content-Type", "Content-Transfer-Encoding"} {
+		if value := header.Get(key); value != "" {
+			writeHeader(buf, key, value)
+		}
+	}
+}
+
+func newMessageID(from string) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	domain := "localhost"
+	if _, host, ok := strings.Cut(from, "@"); ok && host != "" {
+		domain = host
+	}
+
+	return fmt.Sprintf("<%s@%s>", hex.EncodeToString(b), domain), nil
+}
@@ -0,0 +1,121 @@
+package mail
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testFrom = Address{Name: "Event Management", Email: "no-reply@example.com"}
+
+func readMessage(t *testing.T, email *Email) *mail.Message {
+	data, err := email.Bytes(testFrom, time.Now())
+	require.NoError(t, err)
+
+	message, err := mail.ReadMessage(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	return message
+}
+
+func readQuotedPrintable(t *testing.T, r io.Reader) string {
+	data, err := io.ReadAll(quotedprintable.NewReader(r))
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestEmailPlainText(t *testing.T) {
+	email := &Email{
+		To:       []string{"user@example.com"},
+		Bcc:      []string{"audit@example.com"},
+		Subject:  "Welcome ✓",
+		TextBody: "Hello there",
+	}
+
+	message := readMessage(t, email)
+	require.Equal(t, "user@example.com", message.Header.Get("To"))
+	require.Empty(t, message.Header.Get("Bcc"))
+	require.Contains(t, message.Header.Get("From"), "no-reply@example.com")
+	require.NotEmpty(t, message.Header.Get("Message-ID"))
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(message.Header.Get("Subject"))
+	require.NoError(t, err)
+	require.Equal(t, "Welcome ✓", subject)
+
+	mediaType, _, err := mime.ParseMediaType(message.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	require.Equal(t, "text/plain", mediaType)
+	require.Equal(t, "Hello there", readQuotedPrintable(t, message.Body))
+
+	require.Equal(t, []string{"user@example.com", "audit@example.com"}, email.Recipients())
+}
+
+func TestEmailAlternativeWithAttachment(t *testing.T) {
+	attachment := bytes.Repeat([]byte("ticket"), 50)
+	email := &Email{
+		To:       []string{"user@example.com"},
+		Subject:  "Your ticket",
+		TextBody: "Your ticket is attached",
+		HTMLBody: "<p>Your ticket is attached</p>",
+		Attachments: []Attachment{
+			{Filename: "ticket.pdf", Data: attachment},
+		},
+	}
+
+	message := readMessage(t, email)
+
+	mediaType, params, err := mime.ParseMediaType(message.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	require.Equal(t, "multipart/mixed", mediaType)
+
+	mixed := multipart.NewReader(message.Body, params["boundary"])
+
+	// The first part holds both bodies
+	part, err := mixed.NextPart()
+	require.NoError(t, err)
+	mediaType, params, err = mime.ParseMediaType(part.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	require.Equal(t, "multipart/alternative", mediaType)
+
+	alternative := multipart.NewReader(part, params["boundary"])
+	for _, want := range []struct{ contentType, body string }{
+		{"text/plain", email.TextBody},
+		{"text/html", email.HTMLBody},
+	} {
+		bodyPart, err := alternative.NextRawPart()
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(bodyPart.Header.Get("Content-Type"), want.contentType))
+		require.Equal(t, want.body, readQuotedPrintable(t, bodyPart))
+	}
+
+	// The second part is the attachment
+	part, err = mixed.NextRawPart()
+	require.NoError(t, err)
+	require.Equal(t, "application/pdf", part.Header.Get("Content-Type"))
+	require.Equal(t, "ticket.pdf", part.FileName())
+
+	encoded, err := io.ReadAll(part)
+	require.NoError(t, err)
+	for _, line := range strings.Split(strings.TrimSpace(string(encoded)), "\r\n") {
+		require.LessOrEqual(t, len(line), 76)
+	}
+
+	_, err = mixed.NextPart()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestEmailInvalid(t *testing.T) {
+	_, err := (&Email{Subject: "no recipients", TextBody: "body"}).Bytes(testFrom, time.Now())
+	require.Error(t, err)
+
+	_, err = (&Email{To: []string{"user@example.com"}, Subject: "no body"}).Bytes(testFrom, time.Now())
+	require.Error(t, err)
+}
@@ -0,0 +1,33 @@
+package mail
+
+import (
+	"fmt"
+
+	"github.com/yashagw/event-management-api/util"
+)
+
+const (
+	// SenderSMTP delivers emails through an SMTP server
+	SenderSMTP = "smtp"
+	// SenderFile writes emails as .eml files to a local maildir
+	SenderFile = "file"
+)
+
+// EmailSender sends emails
+type EmailSender interface {
+	SendEmail(email *Email) error
+}
+
+// NewEmailSender creates the email sender selected by config.EmailSender
+func NewEmailSender(config util.Config) (EmailSender, error) {
+	from := Address{Name: config.EmailSenderName, Email: config.EmailSenderAddress}
+
+	switch config.EmailSender {
+	case SenderSMTP:
+		return NewSMTPSender(from, config.SMTPHost, config.SMTPPort, config.SMTPUsername, config.SMTPPassword), nil
+	case "", SenderFile:
+		return NewFileSender(from, config.EmailFileDir)
+	default:
+		return nil, fmt.Errorf("unknown email sender: %s", config.EmailSender)
+	}
+}
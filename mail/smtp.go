@@ -0,0 +1,48 @@
+package mail
+
+import (
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"time"
+)
+
+// SMTPSender sends emails through an SMTP server.
+// The connection is upgraded with STARTTLS when the server supports it.
+type SMTPSender struct {
+	from     Address
+	address  string
+	host     string
+	username string
+	password string
+}
+
+func NewSMTPSender(from Address, host string, port int, username string, password string) EmailSender {
+	return &SMTPSender{
+		from:     from,
+		address:  net.JoinHostPort(host, strconv.Itoa(port)),
+		host:     host,
+		username: username,
+		password: password,
+	}
+}
+
+func (sender *SMTPSender) SendEmail(email *Email) error {
+	message, err := email.Bytes(sender.from, time.Now())
+	if err != nil {
+		return fmt.Errorf("could not build email: %w", err)
+	}
+
+	var auth smtp.Auth
+	if sender.username != "" {
+		auth = smtp.PlainAuth("", sender.username, sender.password, sender.host)
+	}
+
+	err = smtp.SendMail(sender.address, auth, sender.from.Email, email.Recipients(), message)
+	if err != nil {
+		return fmt.Errorf("could not send email: %w", err)
+	}
+
+	return nil
+}
@@ -1,27 +1,48 @@
 package main
 
 import (
+	"context"
 	"database/sql"
+	"errors"
+	"fmt"
 	"log"
 	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/hibiken/asynq"
+	"github.com/redis/go-redis/v9"
 	"github.com/yashagw/event-management-api/api"
 	"github.com/yashagw/event-management-api/db"
+	"github.com/yashagw/event-management-api/docs/openapi"
 	"github.com/yashagw/event-management-api/gapi"
+	"github.com/yashagw/event-management-api/mail"
 	"github.com/yashagw/event-management-api/pb"
+	"github.com/yashagw/event-management-api/revocation"
 	"github.com/yashagw/event-management-api/util"
 	"github.com/yashagw/event-management-api/worker"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
-func runTaskProcessor(redisOpt asynq.RedisClientOpt, provider db.Provider) {
-	taskProcessor := worker.NewRedisTaskProcessor(redisOpt, provider)
-	err := taskProcessor.Start()
-	if err != nil {
-		log.Fatal("cannot start task processor:", err)
-	}
+var interruptSignals = []os.Signal{
+	os.Interrupt,
+	syscall.SIGTERM,
+}
+
+// component is a long running part of the process that the supervisor
+// starts on boot and stops on shutdown.
+type component struct {
+	name string
+	// start blocks until the component stops or fails
+	start func() error
+	// stop drains in-flight work, it must make start return
+	stop func(ctx context.Context) error
 }
 
 // @title     Event Mangement API
@@ -43,7 +64,6 @@ func main() {
 	if err != nil {
 		log.Fatal("cannot connect to db:", err)
 	}
-	defer conn.Close()
 
 	provider, err := db.New(conn)
 	if err != nil {
@@ -53,40 +73,331 @@ func main() {
 	redisOpt := asynq.RedisClientOpt{
 		Addr: config.RedisAddress,
 	}
-	taskDistributor := worker.NewRedisTaskDistributor(redisOpt)
-	// go runTaskProcessor(redisOpt, provider)
 
-	runGrpcServer(config, provider, taskDistributor)
+	// The memory broker only reaches the task processor of this process
+	var memoryBroker *worker.MemoryBroker
+	var taskDistributor worker.TaskDistributor
+	var taskInspector worker.TaskInspector
+	switch config.TaskBroker {
+	case worker.BrokerMemory:
+		if !config.RunTaskProcessor {
+			log.Fatal("TASK_BROKER=memory requires RUN_TASK_PROCESSOR")
+		}
+		memoryBroker = worker.NewMemoryBroker()
+		taskDistributor = worker.NewMemoryTaskDistributor(memoryBroker)
+		taskInspector = worker.NewMemoryTaskInspector(memoryBroker)
+	case "", worker.BrokerRedis:
+		taskDistributor = worker.NewRedisTaskDistributor(redisOpt)
+		taskInspector = worker.NewRedisTaskInspector(redisOpt)
+	default:
+		log.Fatal("unknown task broker: ", config.TaskBroker)
+	}
+
+	revocationList := revocation.NewDBList(provider)
+	var redisClient *redis.Client
+	if config.RevocationCache {
+		redisClient = redis.NewClient(&redis.Options{Addr: config.RedisAddress})
+		revocationList = revocation.NewCachedList(revocationList, redisClient)
+	}
+
+	// Components are stopped in this order: HTTP servers first so that no new
+	// work comes in, then gRPC, then the scheduler, the outbox relay and the
+	// task processor.
+	var components []component
+	if config.RunGinServer {
+		components = append(components, newGinServer(config, provider, taskDistributor, taskInspector, revocationList))
+	}
+	if config.RunGrpcServer {
+		components = append(components, newGatewayServer(config))
+		components = append(components, newGrpcServer(config, provider, taskDistributor, taskInspector, revocationList))
+	}
+	if config.RunTaskScheduler {
+		components = append(components, newTaskScheduler(redisOpt, memoryBroker, taskDistributor))
+	}
+	if config.RunOutboxRelay {
+		components = append(components, newOutboxRelay(config, provider, taskDistributor))
+	}
+	if config.RunTaskProcessor {
+		components = append(components, newTaskProcessor(config, redisOpt, memoryBroker, provider))
+	}
+	if len(components) == 0 {
+		log.Fatal("nothing to run: enable at least one of RUN_GIN_SERVER, RUN_GRPC_SERVER, RUN_TASK_SCHEDULER, RUN_OUTBOX_RELAY or RUN_TASK_PROCESSOR")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), interruptSignals...)
+	defer stop()
+
+	err = supervise(ctx, config, components)
+
+	if redisClient != nil {
+		if closeErr := redisClient.Close(); closeErr != nil {
+			log.Println("cannot close redis client:", closeErr)
+		}
+	}
+	if closeErr := provider.Close(); closeErr != nil {
+		log.Println("cannot close db provider:", closeErr)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// supervise starts every component and waits until ctx is cancelled or one of
+// them fails, then stops all of them in order.
+func supervise(ctx context.Context, config util.Config, components []component) error {
+	errs := make(chan error, len(components))
+	for _, c := range components {
+		c := c
+		go func() {
+			log.Printf("starting %s", c.name)
+			err := c.start()
+			if err != nil {
+				err = fmt.Errorf("%s: %w", c.name, err)
+			} else {
+				// A component only returns on its own when something went wrong
+				err = fmt.Errorf("%s stopped", c.name)
+			}
+			errs <- err
+		}()
+	}
+
+	var runErr error
+	select {
+	case <-ctx.Done():
+		log.Println("shutdown signal received")
+	case runErr = <-errs:
+		log.Println("component stopped unexpectedly:", runErr)
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), config.ShutdownTimeout)
+	defer cancel()
+
+	for _, c := range components {
+		log.Printf("stopping %s", c.name)
+		if err := c.stop(shutdownCtx); err != nil {
+			log.Printf("cannot stop %s gracefully: %v", c.name, err)
+		}
+	}
+
+	return runErr
 }
 
-func runGrpcServer(config util.Config, provider db.Provider, taskDistributor worker.TaskDistributor) {
-	server, err := gapi.NewServer(config, provider, taskDistributor)
+// newTaskScheduler enqueues the periodic tasks, the task processor runs them
+func newTaskScheduler(
+	redisOpt asynq.RedisClientOpt,
+	memoryBroker *worker.MemoryBroker,
+	taskDistributor worker.TaskDistributor,
+) component {
+	var scheduler worker.TaskScheduler
+	var err error
+	if memoryBroker != nil {
+		scheduler, err = worker.NewMemoryTaskScheduler(taskDistributor)
+	} else {
+		scheduler, err = worker.NewRedisTaskScheduler(redisOpt)
+	}
+	if err != nil {
+		log.Fatal("cannot create task scheduler:", err)
+	}
+	done := make(chan struct{})
+
+	return component{
+		name: "task scheduler",
+		start: func() error {
+			err := scheduler.Start()
+			if err != nil {
+				return err
+			}
+			<-done
+			return nil
+		},
+		stop: func(ctx context.Context) error {
+			scheduler.Shutdown()
+			close(done)
+			return nil
+		},
+	}
+}
+
+// newOutboxRelay publishes the tasks that handlers write to the outbox table
+func newOutboxRelay(config util.Config, provider db.Provider, taskDistributor worker.TaskDistributor) component {
+	relay := worker.NewOutboxRelay(provider, taskDistributor, config)
+	done := make(chan struct{})
+
+	return component{
+		name: "outbox relay",
+		start: func() error {
+			relay.Start()
+			<-done
+			return nil
+		},
+		stop: func(ctx context.Context) error {
+			relay.Shutdown()
+			close(done)
+			return nil
+		},
+	}
+}
+
+func newTaskProcessor(
+	config util.Config,
+	redisOpt asynq.RedisClientOpt,
+	memoryBroker *worker.MemoryBroker,
+	provider db.Provider,
+) component {
+	mailer, err := mail.NewEmailSender(config)
+	if err != nil {
+		log.Fatal("cannot create email sender:", err)
+	}
+
+	var taskProcessor worker.TaskProcessor
+	if memoryBroker != nil {
+		taskProcessor = worker.NewMemoryTaskProcessor(memoryBroker, provider, config, mailer)
+	} else {
+		taskProcessor = worker.NewRedisTaskProcessor(redisOpt, provider, config, mailer)
+	}
+	done := make(chan struct{})
+
+	return component{
+		name: "task processor",
+		start: func() error {
+			err := taskProcessor.Start()
+			if err != nil {
+				return err
+			}
+			<-done
+			return nil
+		},
+		stop: func(ctx context.Context) error {
+			taskProcessor.Shutdown()
+			close(done)
+			return nil
+		},
+	}
+}
+
+func newGrpcServer(
+	config util.Config,
+	provider db.Provider,
+	taskDistributor worker.TaskDistributor,
+	taskInspector worker.TaskInspector,
+	revocationList revocation.List,
+) component {
+	server, err := gapi.NewServer(config, provider, taskDistributor, taskInspector, revocationList)
 	if err != nil {
 		log.Fatal("cannot create server:", err)
 	}
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(server.UnaryAuthInterceptor),
+		grpc.StreamInterceptor(server.StreamAuthInterceptor),
+	)
 	pb.RegisterEventManagementServer(grpcServer, server)
 	reflection.Register(grpcServer)
 
-	listener, err := net.Listen("tcp", config.GrpcServerAddress)
-	if err != nil {
-		log.Fatal("cannot create listerner:", err)
+	return component{
+		name: "gRPC server",
+		start: func() error {
+			listener, err := net.Listen("tcp", config.GrpcServerAddress)
+			if err != nil {
+				return err
+			}
+			return grpcServer.Serve(listener)
+		},
+		stop: func(ctx context.Context) error {
+			stopped := make(chan struct{})
+			go func() {
+				grpcServer.GracefulStop()
+				close(stopped)
+			}()
+
+			select {
+			case <-stopped:
+				return nil
+			case <-ctx.Done():
+				grpcServer.Stop()
+				return ctx.Err()
+			}
+		},
 	}
-	err = grpcServer.Serve(listener)
+}
+
+// newGatewayServer serves the REST API by proxying every request to the gRPC server,
+// so REST and gRPC clients go through the same handlers and interceptors.
+func newGatewayServer(config util.Config) component {
+	jsonOption := runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+		MarshalOptions: protojson.MarshalOptions{
+			UseProtoNames:   true,
+			EmitUnpopulated: true,
+		},
+		UnmarshalOptions: protojson.UnmarshalOptions{
+			DiscardUnknown: true,
+		},
+	})
+	grpcMux := runtime.NewServeMux(jsonOption)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	err := pb.RegisterEventManagementHandlerFromEndpoint(ctx, grpcMux, config.GrpcServerAddress, opts)
 	if err != nil {
-		log.Fatal("cannot start server:", err)
+		log.Fatal("cannot register handler server:", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/", grpcMux)
+	mux.Handle("/openapi/", http.StripPrefix("/openapi/", http.FileServer(http.FS(openapi.FS))))
+
+	httpServer := &http.Server{
+		Addr:    config.HttpServerAddress,
+		Handler: mux,
+	}
+
+	return component{
+		name: "gateway server",
+		start: func() error {
+			err := httpServer.ListenAndServe()
+			if errors.Is(err, http.ErrServerClosed) {
+				return nil
+			}
+			return err
+		},
+		stop: func(ctx context.Context) error {
+			// Closing the gateway connection only after the drain lets
+			// in-flight requests reach the gRPC server.
+			defer cancel()
+			return httpServer.Shutdown(ctx)
+		},
 	}
 }
 
-func runGinServer(config util.Config, provider db.Provider, taskDistributor worker.TaskDistributor) {
-	server, err := api.NewServer(config, provider, taskDistributor)
+func newGinServer(
+	config util.Config,
+	provider db.Provider,
+	taskDistributor worker.TaskDistributor,
+	taskInspector worker.TaskInspector,
+	revocationList revocation.List,
+) component {
+	server, err := api.NewServer(config, provider, taskDistributor, taskInspector, revocationList)
 	if err != nil {
 		log.Fatal("cannot create server:", err)
 	}
 
-	err = server.Start(config.HttpServerAddress)
-	if err != nil {
-		log.Fatal("cannot start server:", err)
+	httpServer := &http.Server{
+		Addr:    config.GinServerAddress,
+		Handler: server.Handler(),
+	}
+
+	return component{
+		name: "gin server",
+		start: func() error {
+			err := httpServer.ListenAndServe()
+			if errors.Is(err, http.ErrServerClosed) {
+				return nil
+			}
+			return err
+		},
+		stop: func(ctx context.Context) error {
+			return httpServer.Shutdown(ctx)
+		},
 	}
 }
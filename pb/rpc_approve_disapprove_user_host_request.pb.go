@@ -0,0 +1,224 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.12
+// source: rpc_approve_disapprove_user_host_request.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ApproveDisapproveUserHostRequestRequest is the request to approve or disapprove a request to become host
+type ApproveDisapproveUserHostRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId int64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Approved  bool  `protobuf:"varint,2,opt,name=approved,proto3" json:"approved,omitempty"`
+}
+
+func (x *ApproveDisapproveUserHostRequestRequest) Reset() {
+	*x = ApproveDisapproveUserHostRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_approve_disapprove_user_host_request_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveDisapproveUserHostRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveDisapproveUserHostRequestRequest) ProtoMessage() {}
+
+func (x *ApproveDisapproveUserHostRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_approve_disapprove_user_host_request_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveDisapproveUserHostRequestRequest.ProtoReflect.Descriptor instead.
+func (*ApproveDisapproveUserHostRequestRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_approve_disapprove_user_host_request_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ApproveDisapproveUserHostRequestRequest) GetRequestId() int64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *ApproveDisapproveUserHostRequestRequest) GetApproved() bool {
+	if x != nil {
+		return x.Approved
+	}
+	return false
+}
+
+// ApproveDisapproveUserHostRequestResponse is the response to approve or disapprove a request to become host
+type ApproveDisapproveUserHostRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ApproveDisapproveUserHostRequestResponse) Reset() {
+	*x = ApproveDisapproveUserHostRequestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_approve_disapprove_user_host_request_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveDisapproveUserHostRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveDisapproveUserHostRequestResponse) ProtoMessage() {}
+
+func (x *ApproveDisapproveUserHostRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_approve_disapprove_user_host_request_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveDisapproveUserHostRequestResponse.ProtoReflect.Descriptor instead.
+func (*ApproveDisapproveUserHostRequestResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_approve_disapprove_user_host_request_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ApproveDisapproveUserHostRequestResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_rpc_approve_disapprove_user_host_request_proto protoreflect.FileDescriptor
+
+var file_rpc_approve_disapprove_user_host_request_proto_rawDesc = []byte{
+	0x0a, 0x2e, 0x72, 0x70, 0x63, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x5f, 0x64, 0x69,
+	0x73, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x68, 0x6f,
+	0x73, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x02, 0x70, 0x62, 0x22, 0x64, 0x0a, 0x27, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x44,
+	0x69, 0x73, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x48, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x22, 0x44, 0x0a, 0x28, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x73, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x79,
+	0x61, 0x73, 0x68, 0x61, 0x67, 0x77, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2d, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_approve_disapprove_user_host_request_proto_rawDescOnce sync.Once
+	file_rpc_approve_disapprove_user_host_request_proto_rawDescData = file_rpc_approve_disapprove_user_host_request_proto_rawDesc
+)
+
+func file_rpc_approve_disapprove_user_host_request_proto_rawDescGZIP() []byte {
+	file_rpc_approve_disapprove_user_host_request_proto_rawDescOnce.Do(func() {
+		file_rpc_approve_disapprove_user_host_request_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_approve_disapprove_user_host_request_proto_rawDescData)
+	})
+	return file_rpc_approve_disapprove_user_host_request_proto_rawDescData
+}
+
+var file_rpc_approve_disapprove_user_host_request_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_approve_disapprove_user_host_request_proto_goTypes = []interface{}{
+	(*ApproveDisapproveUserHostRequestRequest)(nil),  // 0: pb.ApproveDisapproveUserHostRequestRequest
+	(*ApproveDisapproveUserHostRequestResponse)(nil), // 1: pb.ApproveDisapproveUserHostRequestResponse
+}
+var file_rpc_approve_disapprove_user_host_request_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_rpc_approve_disapprove_user_host_request_proto_init() }
+func file_rpc_approve_disapprove_user_host_request_proto_init() {
+	if File_rpc_approve_disapprove_user_host_request_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_approve_disapprove_user_host_request_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveDisapproveUserHostRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_approve_disapprove_user_host_request_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveDisapproveUserHostRequestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_approve_disapprove_user_host_request_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_approve_disapprove_user_host_request_proto_goTypes,
+		DependencyIndexes: file_rpc_approve_disapprove_user_host_request_proto_depIdxs,
+		MessageInfos:      file_rpc_approve_disapprove_user_host_request_proto_msgTypes,
+	}.Build()
+	File_rpc_approve_disapprove_user_host_request_proto = out.File
+	file_rpc_approve_disapprove_user_host_request_proto_rawDesc = nil
+	file_rpc_approve_disapprove_user_host_request_proto_goTypes = nil
+	file_rpc_approve_disapprove_user_host_request_proto_depIdxs = nil
+}
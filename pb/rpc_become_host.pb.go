@@ -0,0 +1,205 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.12
+// source: rpc_become_host.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// BecomeHostRequest is the request to create a new request to become host
+type BecomeHostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BecomeHostRequest) Reset() {
+	*x = BecomeHostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_become_host_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BecomeHostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BecomeHostRequest) ProtoMessage() {}
+
+func (x *BecomeHostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_become_host_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BecomeHostRequest.ProtoReflect.Descriptor instead.
+func (*BecomeHostRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_become_host_proto_rawDescGZIP(), []int{0}
+}
+
+// BecomeHostResponse is the response to create a new request to become host
+type BecomeHostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Request *UserHostRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *BecomeHostResponse) Reset() {
+	*x = BecomeHostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_become_host_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BecomeHostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BecomeHostResponse) ProtoMessage() {}
+
+func (x *BecomeHostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_become_host_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BecomeHostResponse.ProtoReflect.Descriptor instead.
+func (*BecomeHostResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_become_host_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BecomeHostResponse) GetRequest() *UserHostRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+var File_rpc_become_host_proto protoreflect.FileDescriptor
+
+var file_rpc_become_host_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x72, 0x70, 0x63, 0x5f, 0x62, 0x65, 0x63, 0x6f, 0x6d, 0x65, 0x5f, 0x68, 0x6f, 0x73,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x17, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x13, 0x0a, 0x11, 0x42, 0x65, 0x63, 0x6f, 0x6d, 0x65, 0x48, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x43, 0x0a, 0x12, 0x42, 0x65, 0x63,
+	0x6f, 0x6d, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2d, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x2c,
+	0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x79, 0x61, 0x73,
+	0x68, 0x61, 0x67, 0x77, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_become_host_proto_rawDescOnce sync.Once
+	file_rpc_become_host_proto_rawDescData = file_rpc_become_host_proto_rawDesc
+)
+
+func file_rpc_become_host_proto_rawDescGZIP() []byte {
+	file_rpc_become_host_proto_rawDescOnce.Do(func() {
+		file_rpc_become_host_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_become_host_proto_rawDescData)
+	})
+	return file_rpc_become_host_proto_rawDescData
+}
+
+var file_rpc_become_host_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_become_host_proto_goTypes = []interface{}{
+	(*BecomeHostRequest)(nil),  // 0: pb.BecomeHostRequest
+	(*BecomeHostResponse)(nil), // 1: pb.BecomeHostResponse
+	(*UserHostRequest)(nil),    // 2: pb.UserHostRequest
+}
+var file_rpc_become_host_proto_depIdxs = []int32{
+	2, // 0: pb.BecomeHostResponse.request:type_name -> pb.UserHostRequest
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_become_host_proto_init() }
+func file_rpc_become_host_proto_init() {
+	if File_rpc_become_host_proto != nil {
+		return
+	}
+	file_user_host_request_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_become_host_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BecomeHostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_become_host_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BecomeHostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_become_host_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_become_host_proto_goTypes,
+		DependencyIndexes: file_rpc_become_host_proto_depIdxs,
+		MessageInfos:      file_rpc_become_host_proto_msgTypes,
+	}.Build()
+	File_rpc_become_host_proto = out.File
+	file_rpc_become_host_proto_rawDesc = nil
+	file_rpc_become_host_proto_goTypes = nil
+	file_rpc_become_host_proto_depIdxs = nil
+}
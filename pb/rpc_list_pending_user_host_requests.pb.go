@@ -0,0 +1,238 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.12
+// source: rpc_list_pending_user_host_requests.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ListPendingUserHostRequestsRequest is the request to list pending requests to become host
+type ListPendingUserHostRequestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit  int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *ListPendingUserHostRequestsRequest) Reset() {
+	*x = ListPendingUserHostRequestsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_list_pending_user_host_requests_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPendingUserHostRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingUserHostRequestsRequest) ProtoMessage() {}
+
+func (x *ListPendingUserHostRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_pending_user_host_requests_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingUserHostRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingUserHostRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_pending_user_host_requests_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListPendingUserHostRequestsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListPendingUserHostRequestsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// ListPendingUserHostRequestsResponse is the response to list pending requests to become host
+type ListPendingUserHostRequestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests   []*UserHostRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	NextOffset int32              `protobuf:"varint,2,opt,name=next_offset,json=nextOffset,proto3" json:"next_offset,omitempty"`
+}
+
+func (x *ListPendingUserHostRequestsResponse) Reset() {
+	*x = ListPendingUserHostRequestsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_list_pending_user_host_requests_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPendingUserHostRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingUserHostRequestsResponse) ProtoMessage() {}
+
+func (x *ListPendingUserHostRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_pending_user_host_requests_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingUserHostRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingUserHostRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_pending_user_host_requests_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListPendingUserHostRequestsResponse) GetRequests() []*UserHostRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *ListPendingUserHostRequestsResponse) GetNextOffset() int32 {
+	if x != nil {
+		return x.NextOffset
+	}
+	return 0
+}
+
+var File_rpc_list_pending_user_host_requests_proto protoreflect.FileDescriptor
+
+var file_rpc_list_pending_user_host_requests_proto_rawDesc = []byte{
+	0x0a, 0x29, 0x72, 0x70, 0x63, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a,
+	0x17, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x52, 0x0a, 0x22, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x77, 0x0a, 0x23,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x48,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x48,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x79, 0x61, 0x73, 0x68, 0x61, 0x67, 0x77, 0x2f, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x61, 0x70, 0x69,
+	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_list_pending_user_host_requests_proto_rawDescOnce sync.Once
+	file_rpc_list_pending_user_host_requests_proto_rawDescData = file_rpc_list_pending_user_host_requests_proto_rawDesc
+)
+
+func file_rpc_list_pending_user_host_requests_proto_rawDescGZIP() []byte {
+	file_rpc_list_pending_user_host_requests_proto_rawDescOnce.Do(func() {
+		file_rpc_list_pending_user_host_requests_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_list_pending_user_host_requests_proto_rawDescData)
+	})
+	return file_rpc_list_pending_user_host_requests_proto_rawDescData
+}
+
+var file_rpc_list_pending_user_host_requests_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_pending_user_host_requests_proto_goTypes = []interface{}{
+	(*ListPendingUserHostRequestsRequest)(nil),  // 0: pb.ListPendingUserHostRequestsRequest
+	(*ListPendingUserHostRequestsResponse)(nil), // 1: pb.ListPendingUserHostRequestsResponse
+	(*UserHostRequest)(nil),                     // 2: pb.UserHostRequest
+}
+var file_rpc_list_pending_user_host_requests_proto_depIdxs = []int32{
+	2, // 0: pb.ListPendingUserHostRequestsResponse.requests:type_name -> pb.UserHostRequest
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_pending_user_host_requests_proto_init() }
+func file_rpc_list_pending_user_host_requests_proto_init() {
+	if File_rpc_list_pending_user_host_requests_proto != nil {
+		return
+	}
+	file_user_host_request_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_list_pending_user_host_requests_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPendingUserHostRequestsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_list_pending_user_host_requests_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPendingUserHostRequestsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_list_pending_user_host_requests_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_pending_user_host_requests_proto_goTypes,
+		DependencyIndexes: file_rpc_list_pending_user_host_requests_proto_depIdxs,
+		MessageInfos:      file_rpc_list_pending_user_host_requests_proto_msgTypes,
+	}.Build()
+	File_rpc_list_pending_user_host_requests_proto = out.File
+	file_rpc_list_pending_user_host_requests_proto_rawDesc = nil
+	file_rpc_list_pending_user_host_requests_proto_goTypes = nil
+	file_rpc_list_pending_user_host_requests_proto_depIdxs = nil
+}
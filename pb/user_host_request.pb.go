@@ -0,0 +1,268 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.12
+// source: user_host_request.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Enum to represent the status of a request to become host
+type UserHostRequestStatus int32
+
+const (
+	UserHostRequestStatus_UserHostRequestStatus_Pending  UserHostRequestStatus = 0
+	UserHostRequestStatus_UserHostRequestStatus_Rejected UserHostRequestStatus = 1
+	UserHostRequestStatus_UserHostRequestStatus_Approved UserHostRequestStatus = 2
+)
+
+// Enum value maps for UserHostRequestStatus.
+var (
+	UserHostRequestStatus_name = map[int32]string{
+		0: "UserHostRequestStatus_Pending",
+		1: "UserHostRequestStatus_Rejected",
+		2: "UserHostRequestStatus_Approved",
+	}
+	UserHostRequestStatus_value = map[string]int32{
+		"UserHostRequestStatus_Pending":  0,
+		"UserHostRequestStatus_Rejected": 1,
+		"UserHostRequestStatus_Approved": 2,
+	}
+)
+
+func (x UserHostRequestStatus) Enum() *UserHostRequestStatus {
+	p := new(UserHostRequestStatus)
+	*p = x
+	return p
+}
+
+func (x UserHostRequestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserHostRequestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_user_host_request_proto_enumTypes[0].Descriptor()
+}
+
+func (UserHostRequestStatus) Type() protoreflect.EnumType {
+	return &file_user_host_request_proto_enumTypes[0]
+}
+
+func (x UserHostRequestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserHostRequestStatus.Descriptor instead.
+func (UserHostRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_user_host_request_proto_rawDescGZIP(), []int{0}
+}
+
+// UserHostRequest represents a request to become a host in the database
+type UserHostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId      int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ModeratorId int64                  `protobuf:"varint,3,opt,name=moderator_id,json=moderatorId,proto3" json:"moderator_id,omitempty"`
+	Status      UserHostRequestStatus  `protobuf:"varint,4,opt,name=status,proto3,enum=pb.UserHostRequestStatus" json:"status,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *UserHostRequest) Reset() {
+	*x = UserHostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_host_request_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserHostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserHostRequest) ProtoMessage() {}
+
+func (x *UserHostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_host_request_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserHostRequest.ProtoReflect.Descriptor instead.
+func (*UserHostRequest) Descriptor() ([]byte, []int) {
+	return file_user_host_request_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UserHostRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserHostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserHostRequest) GetModeratorId() int64 {
+	if x != nil {
+		return x.ModeratorId
+	}
+	return 0
+}
+
+func (x *UserHostRequest) GetStatus() UserHostRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UserHostRequestStatus_UserHostRequestStatus_Pending
+}
+
+func (x *UserHostRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *UserHostRequest) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_user_host_request_proto protoreflect.FileDescriptor
+
+var file_user_host_request_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x86,
+	0x02, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d,
+	0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x31,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
+	0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x2a, 0x82, 0x01, 0x0a, 0x15, 0x55, 0x73, 0x65, 0x72,
+	0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x21, 0x0a, 0x1d, 0x55, 0x73, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x55, 0x73, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x52, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x55, 0x73, 0x65, 0x72,
+	0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x5f, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x10, 0x02, 0x42, 0x2c, 0x5a, 0x2a,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x79, 0x61, 0x73, 0x68, 0x61,
+	0x67, 0x77, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_user_host_request_proto_rawDescOnce sync.Once
+	file_user_host_request_proto_rawDescData = file_user_host_request_proto_rawDesc
+)
+
+func file_user_host_request_proto_rawDescGZIP() []byte {
+	file_user_host_request_proto_rawDescOnce.Do(func() {
+		file_user_host_request_proto_rawDescData = protoimpl.X.CompressGZIP(file_user_host_request_proto_rawDescData)
+	})
+	return file_user_host_request_proto_rawDescData
+}
+
+var file_user_host_request_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_user_host_request_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_user_host_request_proto_goTypes = []interface{}{
+	(UserHostRequestStatus)(0),    // 0: pb.UserHostRequestStatus
+	(*UserHostRequest)(nil),       // 1: pb.UserHostRequest
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_user_host_request_proto_depIdxs = []int32{
+	0, // 0: pb.UserHostRequest.status:type_name -> pb.UserHostRequestStatus
+	2, // 1: pb.UserHostRequest.created_at:type_name -> google.protobuf.Timestamp
+	2, // 2: pb.UserHostRequest.updated_at:type_name -> google.protobuf.Timestamp
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_user_host_request_proto_init() }
+func file_user_host_request_proto_init() {
+	if File_user_host_request_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_user_host_request_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserHostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_user_host_request_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_user_host_request_proto_goTypes,
+		DependencyIndexes: file_user_host_request_proto_depIdxs,
+		EnumInfos:         file_user_host_request_proto_enumTypes,
+		MessageInfos:      file_user_host_request_proto_msgTypes,
+	}.Build()
+	File_user_host_request_proto = out.File
+	file_user_host_request_proto_rawDesc = nil
+	file_user_host_request_proto_goTypes = nil
+	file_user_host_request_proto_depIdxs = nil
+}
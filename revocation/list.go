@@ -0,0 +1,43 @@
+package revocation
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yashagw/event-management-api/db"
+	"github.com/yashagw/event-management-api/db/model"
+)
+
+// List keeps track of tokens that were revoked before they expired.
+// Tokens are identified by the ID of their payload.
+type List interface {
+	// Revoke adds a token to the list, expiresAt is the time after which the
+	// token would be rejected anyway
+	Revoke(context context.Context, id uuid.UUID, userID int64, expiresAt time.Time) error
+	// IsRevoked reports whether a token was revoked
+	IsRevoked(context context.Context, id uuid.UUID) (bool, error)
+}
+
+// DBList is a revocation list stored in the database
+type DBList struct {
+	provider db.Provider
+}
+
+func NewDBList(provider db.Provider) List {
+	return &DBList{
+		provider: provider,
+	}
+}
+
+func (list *DBList) Revoke(context context.Context, id uuid.UUID, userID int64, expiresAt time.Time) error {
+	return list.provider.RevokeToken(context, model.RevokeTokenParams{
+		ID:        id,
+		UserID:    userID,
+		ExpiresAt: expiresAt,
+	})
+}
+
+func (list *DBList) IsRevoked(context context.Context, id uuid.UUID) (bool, error) {
+	return list.provider.IsTokenRevoked(context, id)
+}
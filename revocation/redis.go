@@ -0,0 +1,78 @@
+package revocation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	cacheKeyPrefix = "revoked_token:"
+	revokedValue   = "1"
+	validValue     = "0"
+	// validTTL bounds how long a token is trusted without asking the next list
+	validTTL = time.Minute
+)
+
+// CachedList caches the answers of another list in Redis so that most
+// requests do not reach the database.
+// Revocations are written through to both, and a cached "not revoked" answer
+// never overwrites a revocation.
+type CachedList struct {
+	next  List
+	redis *redis.Client
+}
+
+func NewCachedList(next List, client *redis.Client) List {
+	return &CachedList{
+		next:  next,
+		redis: client,
+	}
+}
+
+func cacheKey(id uuid.UUID) string {
+	return cacheKeyPrefix + id.String()
+}
+
+func (list *CachedList) Revoke(context context.Context, id uuid.UUID, userID int64, expiresAt time.Time) error {
+	err := list.next.Revoke(context, id, userID, expiresAt)
+	if err != nil {
+		return err
+	}
+
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		// The token has already expired, there is nothing to cache
+		return nil
+	}
+
+	err = list.redis.Set(context, cacheKey(id), revokedValue, ttl).Err()
+	if err != nil {
+		return fmt.Errorf("failed to cache revoked token: %w", err)
+	}
+
+	return nil
+}
+
+func (list *CachedList) IsRevoked(context context.Context, id uuid.UUID) (bool, error) {
+	value, err := list.redis.Get(context, cacheKey(id)).Result()
+	if err == nil {
+		return value == revokedValue, nil
+	}
+
+	// On a cache miss or an unavailable cache fall back to the next list
+	revoked, nextErr := list.next.IsRevoked(context, id)
+	if nextErr != nil {
+		return false, nextErr
+	}
+
+	if err == redis.Nil && !revoked {
+		// SetNX so that a concurrent revocation is not overwritten
+		list.redis.SetNX(context, cacheKey(id), validValue, validTTL)
+	}
+
+	return revoked, nil
+}
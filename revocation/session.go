@@ -0,0 +1,50 @@
+package revocation
+
+import (
+	"context"
+	"time"
+
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/token"
+)
+
+// RevokeSessions revokes the token of the caller, and the refresh token and
+// the latest access token of each session
+func RevokeSessions(context context.Context, list List, caller *token.Payload, sessions []model.Session, accessTokenDuration time.Duration) error {
+	err := list.Revoke(context, caller.ID, caller.UserID, caller.ExpiredAt)
+	if err != nil {
+		return err
+	}
+
+	for _, session := range sessions {
+		// The ID of a session is the ID of its refresh token
+		if session.ID != caller.ID {
+			err := list.Revoke(context, session.ID, session.UserID, session.ExpiresAt)
+			if err != nil {
+				return err
+			}
+		}
+
+		if session.AccessTokenID.Valid && session.AccessTokenID.UUID == caller.ID {
+			continue
+		}
+		err := RevokeSessionAccessToken(context, list, session, accessTokenDuration)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RevokeSessionAccessToken revokes the latest access token issued for a
+// session, if there is one.
+// An access token issued now expires within accessTokenDuration, so that is
+// how long the revocation has to be kept for.
+func RevokeSessionAccessToken(context context.Context, list List, session model.Session, accessTokenDuration time.Duration) error {
+	if !session.AccessTokenID.Valid {
+		return nil
+	}
+
+	return list.Revoke(context, session.AccessTokenID.UUID, session.UserID, time.Now().Add(accessTokenDuration))
+}
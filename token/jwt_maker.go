@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/yashagw/event-management-api/db/model"
 )
 
 const minSecretKeySize = 12
@@ -23,8 +24,8 @@ func NewJWTMaker(secretkey string) (Maker, error) {
 	return &JWTMaker{secretkey}, nil
 }
 
-func (maker *JWTMaker) CreateToken(username string, duration time.Duration) (string, *Payload, error) {
-	payload, err := NewPayload(username, duration)
+func (maker *JWTMaker) CreateToken(username string, userID int64, role model.UserRole, tokenType TokenType, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewPayload(username, userID, role, tokenType, duration)
 	if err != nil {
 		return "", payload, err
 	}
@@ -1,8 +1,43 @@
 package token
 
-import "time"
+import (
+	"fmt"
+	"time"
+
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/util"
+)
+
+const (
+	// MakerPaseto signs tokens with PASETO v2.local and TOKEN_SYMMETRIC_KEY
+	MakerPaseto = "paseto"
+	// MakerPasetoPublic signs tokens with PASETO v4.public and TOKEN_SECRET_KEY
+	MakerPasetoPublic = "paseto_public"
+)
 
 type Maker interface {
-	CreateToken(username string, duration time.Duration) (string, *Payload, error)
+	// CreateToken creates a new token of tokenType for a specific user, role and duration
+	CreateToken(username string, userID int64, role model.UserRole, tokenType TokenType, duration time.Duration) (string, *Payload, error)
+	Verifier
+}
+
+// Verifier checks a token and returns its payload
+type Verifier interface {
 	VerifyToken(token string) (*Payload, error)
 }
+
+// NewMaker creates the token maker selected by config.TokenMaker
+func NewMaker(config util.Config) (Maker, error) {
+	switch config.TokenMaker {
+	case "", MakerPaseto:
+		return NewPasetoMaker(config.TokenSymmetricKey)
+	case MakerPasetoPublic:
+		publicKeys, err := ParsePublicKeys(config.TokenPublicKeys)
+		if err != nil {
+			return nil, err
+		}
+		return NewPasetoPublicMaker(config.TokenKeyID, config.TokenSecretKey, publicKeys)
+	default:
+		return nil, fmt.Errorf("unknown token maker: %s", config.TokenMaker)
+	}
+}
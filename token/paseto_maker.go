@@ -6,6 +6,7 @@ import (
 
 	"github.com/aead/chacha20poly1305"
 	"github.com/o1egl/paseto"
+	"github.com/yashagw/event-management-api/db/model"
 )
 
 // PasetoMaker is a PASETO token maker
@@ -28,9 +29,9 @@ func NewPasetoMaker(symmetricKey string) (Maker, error) {
 	return maker, nil
 }
 
-// CreateToken creates a new token for a specific username and duration
-func (maker *PasetoMaker) CreateToken(username string, duration time.Duration) (string, *Payload, error) {
-	payload, err := NewPayload(username, duration)
+// CreateToken creates a new token of tokenType for a specific user, role and duration
+func (maker *PasetoMaker) CreateToken(username string, userID int64, role model.UserRole, tokenType TokenType, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewPayload(username, userID, role, tokenType, duration)
 	if err != nil {
 		return "", payload, err
 	}
@@ -0,0 +1,167 @@
+package token
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"aidanwoods.dev/go-paseto"
+	"github.com/yashagw/event-management-api/db/model"
+)
+
+// keyFooter is the footer of a v4.public token, it tells which key signed it
+type keyFooter struct {
+	KeyID string `json:"kid"`
+}
+
+// PasetoPublicVerifier verifies PASETO v4.public tokens. It only needs the
+// public keys, so it can be used by services that must not sign tokens.
+type PasetoPublicVerifier struct {
+	publicKeys map[string]paseto.V4AsymmetricPublicKey
+}
+
+// PasetoPublicMaker is a PASETO v4.public token maker.
+// It signs tokens with an Ed25519 key and verifies them with any of the known
+// public keys, which lets the signing key be rotated without invalidating the
+// tokens signed by the previous one.
+type PasetoPublicMaker struct {
+	*PasetoPublicVerifier
+	keyID     string
+	secretKey paseto.V4AsymmetricSecretKey
+}
+
+// NewPasetoPublicVerifier creates a new PasetoPublicVerifier from hex encoded
+// Ed25519 public keys indexed by key ID
+func NewPasetoPublicVerifier(publicKeysHex map[string]string) (*PasetoPublicVerifier, error) {
+	verifier := &PasetoPublicVerifier{
+		publicKeys: make(map[string]paseto.V4AsymmetricPublicKey, len(publicKeysHex)),
+	}
+
+	for keyID, publicKeyHex := range publicKeysHex {
+		publicKey, err := paseto.NewV4AsymmetricPublicKeyFromHex(publicKeyHex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key %q: %w", keyID, err)
+		}
+		verifier.publicKeys[keyID] = publicKey
+	}
+
+	return verifier, nil
+}
+
+// NewPasetoPublicMaker creates a new PasetoPublicMaker signing with the hex
+// encoded Ed25519 secret key identified by keyID.
+// The public key of the secret key is always accepted, publicKeysHex holds
+// the other keys that are still accepted, e.g. the previous signing key.
+func NewPasetoPublicMaker(keyID string, secretKeyHex string, publicKeysHex map[string]string) (Maker, error) {
+	if keyID == "" {
+		return nil, fmt.Errorf("key id must not be empty")
+	}
+
+	secretKey, err := paseto.NewV4AsymmetricSecretKeyFromHex(secretKeyHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid secret key: %w", err)
+	}
+
+	verifier, err := NewPasetoPublicVerifier(publicKeysHex)
+	if err != nil {
+		return nil, err
+	}
+
+	if publicKey, ok := verifier.publicKeys[keyID]; ok && publicKey.ExportHex() != secretKey.Public().ExportHex() {
+		return nil, fmt.Errorf("public key %q does not match the secret key", keyID)
+	}
+	verifier.publicKeys[keyID] = secretKey.Public()
+
+	maker := &PasetoPublicMaker{
+		PasetoPublicVerifier: verifier,
+		keyID:                keyID,
+		secretKey:            secretKey,
+	}
+
+	return maker, nil
+}
+
+// CreateToken creates a new token of tokenType for a specific user, role and duration
+func (maker *PasetoPublicMaker) CreateToken(username string, userID int64, role model.UserRole, tokenType TokenType, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewPayload(username, userID, role, tokenType, duration)
+	if err != nil {
+		return "", payload, err
+	}
+
+	claims, err := json.Marshal(payload)
+	if err != nil {
+		return "", payload, err
+	}
+
+	footer, err := json.Marshal(keyFooter{KeyID: maker.keyID})
+	if err != nil {
+		return "", payload, err
+	}
+
+	token, err := paseto.NewTokenFromClaimsJSON(claims, footer)
+	if err != nil {
+		return "", payload, err
+	}
+
+	return token.V4Sign(maker.secretKey, nil), payload, nil
+}
+
+func (verifier *PasetoPublicVerifier) VerifyToken(token string) (*Payload, error) {
+	// The footer is authenticated by the signature, reading it first is only
+	// used to pick the key the signature is checked with
+	parser := paseto.NewParserWithoutExpiryCheck()
+	footerBytes, err := parser.UnsafeParseFooter(paseto.V4Public, token)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	var footer keyFooter
+	err = json.Unmarshal(footerBytes, &footer)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	publicKey, ok := verifier.publicKeys[footer.KeyID]
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+
+	parsed, err := parser.ParseV4Public(publicKey, token, nil)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	payload := &Payload{}
+	err = json.Unmarshal(parsed.ClaimsJSON(), payload)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	err = payload.Valid()
+	if err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+// ParsePublicKeys parses a comma separated list of key ID and hex encoded
+// public key pairs, e.g. "2023-06=ab12...,2023-01=cd34..."
+func ParsePublicKeys(s string) (map[string]string, error) {
+	publicKeys := map[string]string{}
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		keyID, publicKey, ok := strings.Cut(pair, "=")
+		if !ok || keyID == "" || publicKey == "" {
+			return nil, fmt.Errorf("invalid public key entry %q: expected key_id=hex", pair)
+		}
+		publicKeys[keyID] = publicKey
+	}
+
+	return publicKeys, nil
+}
@@ -0,0 +1,154 @@
+package token
+
+import (
+	"testing"
+	"time"
+
+	"aidanwoods.dev/go-paseto"
+	"github.com/stretchr/testify/require"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/util"
+)
+
+func TestPasetoPublicMaker(t *testing.T) {
+	secretKey := paseto.NewV4AsymmetricSecretKey()
+	maker, err := NewPasetoPublicMaker("key-1", secretKey.ExportHex(), nil)
+	require.NoError(t, err)
+
+	username := util.RandomString(8)
+	userID := util.RandomInt(1, 1000)
+	role := model.UserRole_Host
+	duration := time.Minute
+
+	issuedAt := time.Now()
+	expiredAt := issuedAt.Add(duration)
+
+	token, payload, err := maker.CreateToken(username, userID, role, TokenTypeAccess, duration)
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+	require.NotEmpty(t, payload)
+
+	payload, err = maker.VerifyToken(token)
+	require.NoError(t, err)
+
+	require.NotZero(t, payload.ID)
+	require.Equal(t, username, payload.Username)
+	require.Equal(t, userID, payload.UserID)
+	require.Equal(t, role, payload.Role)
+	require.Equal(t, TokenTypeAccess, payload.Type)
+	require.WithinDuration(t, issuedAt, payload.IssuedAt, time.Second)
+	require.WithinDuration(t, expiredAt, payload.ExpiredAt, time.Second)
+
+	// Services holding only the public key can verify the token
+	verifier, err := NewPasetoPublicVerifier(map[string]string{"key-1": secretKey.Public().ExportHex()})
+	require.NoError(t, err)
+
+	payload, err = verifier.VerifyToken(token)
+	require.NoError(t, err)
+	require.Equal(t, username, payload.Username)
+}
+
+func TestExpiredPasetoPublicToken(t *testing.T) {
+	maker, err := NewPasetoPublicMaker("key-1", paseto.NewV4AsymmetricSecretKey().ExportHex(), nil)
+	require.NoError(t, err)
+
+	token, payload, err := maker.CreateToken(util.RandomString(8), util.RandomInt(1, 1000), model.UserRole_User, TokenTypeAccess, -time.Minute)
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+	require.NotEmpty(t, payload)
+
+	payload, err = maker.VerifyToken(token)
+	require.Error(t, err)
+	require.EqualError(t, err, ErrExpiredToken.Error())
+	require.Nil(t, payload)
+}
+
+func TestPasetoPublicKeyRotation(t *testing.T) {
+	oldKey := paseto.NewV4AsymmetricSecretKey()
+	newKey := paseto.NewV4AsymmetricSecretKey()
+
+	oldMaker, err := NewPasetoPublicMaker("old", oldKey.ExportHex(), nil)
+	require.NoError(t, err)
+
+	oldToken, _, err := oldMaker.CreateToken(util.RandomString(8), util.RandomInt(1, 1000), model.UserRole_User, TokenTypeAccess, time.Minute)
+	require.NoError(t, err)
+
+	// After the rotation the old public key is still accepted
+	newMaker, err := NewPasetoPublicMaker("new", newKey.ExportHex(), map[string]string{
+		"old": oldKey.Public().ExportHex(),
+	})
+	require.NoError(t, err)
+
+	_, err = newMaker.VerifyToken(oldToken)
+	require.NoError(t, err)
+
+	newToken, _, err := newMaker.CreateToken(util.RandomString(8), util.RandomInt(1, 1000), model.UserRole_User, TokenTypeAccess, time.Minute)
+	require.NoError(t, err)
+
+	_, err = newMaker.VerifyToken(newToken)
+	require.NoError(t, err)
+
+	// Once the old key is dropped its tokens are rejected
+	_, err = oldMaker.VerifyToken(newToken)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+
+	droppedMaker, err := NewPasetoPublicMaker("new", newKey.ExportHex(), nil)
+	require.NoError(t, err)
+
+	_, err = droppedMaker.VerifyToken(oldToken)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+}
+
+func TestInvalidPasetoPublicToken(t *testing.T) {
+	key := paseto.NewV4AsymmetricSecretKey()
+	maker, err := NewPasetoPublicMaker("key-1", key.ExportHex(), nil)
+	require.NoError(t, err)
+
+	// A token that claims a known key ID but is signed by another key
+	otherMaker, err := NewPasetoPublicMaker("key-1", paseto.NewV4AsymmetricSecretKey().ExportHex(), nil)
+	require.NoError(t, err)
+
+	token, _, err := otherMaker.CreateToken(util.RandomString(8), util.RandomInt(1, 1000), model.UserRole_User, TokenTypeAccess, time.Minute)
+	require.NoError(t, err)
+
+	payload, err := maker.VerifyToken(token)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+	require.Nil(t, payload)
+
+	// A v2.local token is not a v4.public token
+	localMaker, err := NewPasetoMaker(util.RandomString(32))
+	require.NoError(t, err)
+
+	token, _, err = localMaker.CreateToken(util.RandomString(8), util.RandomInt(1, 1000), model.UserRole_User, TokenTypeAccess, time.Minute)
+	require.NoError(t, err)
+
+	payload, err = maker.VerifyToken(token)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+	require.Nil(t, payload)
+}
+
+func TestNewPasetoPublicMakerMismatchedKey(t *testing.T) {
+	_, err := NewPasetoPublicMaker("key-1", paseto.NewV4AsymmetricSecretKey().ExportHex(), map[string]string{
+		"key-1": paseto.NewV4AsymmetricSecretKey().Public().ExportHex(),
+	})
+	require.Error(t, err)
+
+	_, err = NewPasetoPublicMaker("", paseto.NewV4AsymmetricSecretKey().ExportHex(), nil)
+	require.Error(t, err)
+
+	_, err = NewPasetoPublicMaker("key-1", "invalid", nil)
+	require.Error(t, err)
+}
+
+func TestParsePublicKeys(t *testing.T) {
+	publicKeys, err := ParsePublicKeys("a=01, b=02,")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"a": "01", "b": "02"}, publicKeys)
+
+	publicKeys, err = ParsePublicKeys("")
+	require.NoError(t, err)
+	require.Empty(t, publicKeys)
+
+	_, err = ParsePublicKeys("a")
+	require.Error(t, err)
+}
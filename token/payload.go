@@ -5,21 +5,35 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/yashagw/event-management-api/db/model"
 )
 
 var (
-	ErrExpiredToken = errors.New("token has expired")
-	ErrInvalidToken = errors.New("token is invalid")
+	ErrExpiredToken     = errors.New("token has expired")
+	ErrInvalidToken     = errors.New("token is invalid")
+	ErrInvalidTokenType = errors.New("token type is invalid")
+)
+
+// TokenType tells access tokens apart from refresh tokens, so that a long
+// lived refresh token cannot be used in place of an access token
+type TokenType string
+
+const (
+	TokenTypeAccess  TokenType = "access"
+	TokenTypeRefresh TokenType = "refresh"
 )
 
 type Payload struct {
-	ID        uuid.UUID `json:"id"`
-	Username  string    `json:"username"`
-	IssuedAt  time.Time `json:"issued_at"`
-	ExpiredAt time.Time `json:"expired_at"`
+	ID        uuid.UUID      `json:"id"`
+	Type      TokenType      `json:"token_type"`
+	Username  string         `json:"username"`
+	UserID    int64          `json:"user_id"`
+	Role      model.UserRole `json:"role"`
+	IssuedAt  time.Time      `json:"issued_at"`
+	ExpiredAt time.Time      `json:"expired_at"`
 }
 
-func NewPayload(username string, duration time.Duration) (*Payload, error) {
+func NewPayload(username string, userID int64, role model.UserRole, tokenType TokenType, duration time.Duration) (*Payload, error) {
 	tokenId, err := uuid.NewRandom()
 	if err != nil {
 		return nil, err
@@ -27,7 +41,10 @@ func NewPayload(username string, duration time.Duration) (*Payload, error) {
 
 	payload := &Payload{
 		ID:        tokenId,
+		Type:      tokenType,
 		Username:  username,
+		UserID:    userID,
+		Role:      role,
 		IssuedAt:  time.Now(),
 		ExpiredAt: time.Now().Add(duration),
 	}
@@ -42,3 +59,13 @@ func (payload *Payload) Valid() error {
 
 	return nil
 }
+
+// CheckType returns ErrInvalidTokenType unless the token is of tokenType,
+// tokens issued without a type are rejected too
+func (payload *Payload) CheckType(tokenType TokenType) error {
+	if payload.Type != tokenType {
+		return ErrInvalidTokenType
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package token
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/util"
+)
+
+func TestPayloadCheckType(t *testing.T) {
+	payload, err := NewPayload(util.RandomString(8), util.RandomInt(1, 1000), model.UserRole_User, TokenTypeRefresh, time.Minute)
+	require.NoError(t, err)
+
+	require.NoError(t, payload.CheckType(TokenTypeRefresh))
+	require.ErrorIs(t, payload.CheckType(TokenTypeAccess), ErrInvalidTokenType)
+
+	// Tokens issued before the type was added have none
+	payload.Type = ""
+	require.ErrorIs(t, payload.CheckType(TokenTypeAccess), ErrInvalidTokenType)
+}
@@ -1,19 +1,46 @@
 package util
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/spf13/viper"
 )
 
 type Config struct {
-	DBDriver            string        `mapstructure:"DB_DRIVER"`
-	DBSource            string        `mapstructure:"DB_SOURCE"`
-	HttpServerAddress   string        `mapstructure:"HTTP_SERVER_ADDRESS"`
-	GrpcServerAddress   string        `mapstructure:"GRPC_SERVER_ADDRESS"`
-	TokenSymmetricKey   string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
-	AccessTokenDuration time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
-	RedisAddress        string        `mapstructure:"REDIS_ADDRESS"`
+	DBDriver             string        `mapstructure:"DB_DRIVER"`
+	DBSource             string        `mapstructure:"DB_SOURCE"`
+	HttpServerAddress    string        `mapstructure:"HTTP_SERVER_ADDRESS"`
+	GrpcServerAddress    string        `mapstructure:"GRPC_SERVER_ADDRESS"`
+	TokenMaker           string        `mapstructure:"TOKEN_MAKER"`
+	TokenSymmetricKey    string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
+	TokenKeyID           string        `mapstructure:"TOKEN_KEY_ID"`
+	TokenSecretKey       string        `mapstructure:"TOKEN_SECRET_KEY"`
+	TokenPublicKeys      string        `mapstructure:"TOKEN_PUBLIC_KEYS"`
+	AccessTokenDuration  time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
+	RefreshTokenDuration time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
+	RedisAddress         string        `mapstructure:"REDIS_ADDRESS"`
+	TaskBroker           string        `mapstructure:"TASK_BROKER"`
+	GinServerAddress     string        `mapstructure:"GIN_SERVER_ADDRESS"`
+	RunGinServer         bool          `mapstructure:"RUN_GIN_SERVER"`
+	RunGrpcServer        bool          `mapstructure:"RUN_GRPC_SERVER"`
+	RunTaskProcessor     bool          `mapstructure:"RUN_TASK_PROCESSOR"`
+	RunTaskScheduler     bool          `mapstructure:"RUN_TASK_SCHEDULER"`
+	RunOutboxRelay       bool          `mapstructure:"RUN_OUTBOX_RELAY"`
+	OutboxRelayInterval  time.Duration `mapstructure:"OUTBOX_RELAY_INTERVAL"`
+	OutboxBatchSize      int           `mapstructure:"OUTBOX_BATCH_SIZE"`
+	ShutdownTimeout      time.Duration `mapstructure:"SHUTDOWN_TIMEOUT"`
+	RevocationCache      bool          `mapstructure:"REVOCATION_CACHE"`
+	VerifyEmailURL       string        `mapstructure:"VERIFY_EMAIL_URL"`
+	VerifyEmailInterval  time.Duration `mapstructure:"VERIFY_EMAIL_INTERVAL"`
+	EmailSender          string        `mapstructure:"EMAIL_SENDER"`
+	EmailSenderName      string        `mapstructure:"EMAIL_SENDER_NAME"`
+	EmailSenderAddress   string        `mapstructure:"EMAIL_SENDER_ADDRESS"`
+	EmailFileDir         string        `mapstructure:"EMAIL_FILE_DIR"`
+	SMTPHost             string        `mapstructure:"SMTP_HOST"`
+	SMTPPort             int           `mapstructure:"SMTP_PORT"`
+	SMTPUsername         string        `mapstructure:"SMTP_USERNAME"`
+	SMTPPassword         string        `mapstructure:"SMTP_PASSWORD"`
 }
 
 func LoadConfig(path string) (config Config, err error) {
@@ -21,15 +48,63 @@ func LoadConfig(path string) (config Config, err error) {
 	viper.SetConfigName("app")
 	viper.SetConfigType("env")
 
+	viper.SetDefault("TOKEN_MAKER", "paseto")
+	viper.SetDefault("TASK_BROKER", "redis")
+	viper.SetDefault("RUN_GRPC_SERVER", true)
+	viper.SetDefault("RUN_GIN_SERVER", false)
+	viper.SetDefault("RUN_TASK_PROCESSOR", true)
+	viper.SetDefault("RUN_TASK_SCHEDULER", false)
+	viper.SetDefault("RUN_OUTBOX_RELAY", true)
+	viper.SetDefault("OUTBOX_RELAY_INTERVAL", time.Second)
+	viper.SetDefault("OUTBOX_BATCH_SIZE", 100)
+	viper.SetDefault("SHUTDOWN_TIMEOUT", 10*time.Second)
+	viper.SetDefault("REVOCATION_CACHE", false)
+	viper.SetDefault("VERIFY_EMAIL_URL", "http://localhost:8080/users/verify_email")
+	viper.SetDefault("VERIFY_EMAIL_INTERVAL", time.Minute)
+	viper.SetDefault("EMAIL_SENDER", "file")
+	viper.SetDefault("EMAIL_SENDER_NAME", "Event Management")
+	viper.SetDefault("EMAIL_SENDER_ADDRESS", "no-reply@localhost")
+	viper.SetDefault("EMAIL_FILE_DIR", "tmp/mail")
+	viper.SetDefault("SMTP_PORT", 587)
+
 	//viper will automatically change the values in the config file if
 	//they exists in the environment
 	viper.AutomaticEnv()
 
+	// AutomaticEnv only covers keys viper already knows, so keys that are
+	// usually set in the environment alone have to be bound explicitly
+	viper.BindEnv("TOKEN_KEY_ID")
+	viper.BindEnv("TOKEN_SECRET_KEY")
+	viper.BindEnv("TOKEN_PUBLIC_KEYS")
+	viper.BindEnv("SMTP_HOST")
+	viper.BindEnv("SMTP_USERNAME")
+	viper.BindEnv("SMTP_PASSWORD")
+
 	err = viper.ReadInConfig()
 	if err != nil {
 		return
 	}
 
 	err = viper.Unmarshal(&config)
+	if err != nil {
+		return
+	}
+
+	// The gin server listens on the HTTP address unless it is given its own
+	if config.GinServerAddress == "" {
+		config.GinServerAddress = config.HttpServerAddress
+	}
+
+	err = config.validate()
 	return
 }
+
+// validate reports settings that cannot work together
+func (config Config) validate() error {
+	// The gRPC gateway listens on the HTTP address
+	if config.RunGinServer && config.RunGrpcServer && config.GinServerAddress == config.HttpServerAddress {
+		return fmt.Errorf("GIN_SERVER_ADDRESS must differ from HTTP_SERVER_ADDRESS (%s) when RUN_GIN_SERVER and RUN_GRPC_SERVER are both set", config.HttpServerAddress)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package util
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigValidate(t *testing.T) {
+	testcases := []struct {
+		name   string
+		config Config
+		valid  bool
+	}{
+		{
+			name: "Same Address",
+			config: Config{
+				HttpServerAddress: "0.0.0.0:8080",
+				GinServerAddress:  "0.0.0.0:8080",
+				RunGinServer:      true,
+				RunGrpcServer:     true,
+			},
+			valid: false,
+		},
+		{
+			name: "Different Address",
+			config: Config{
+				HttpServerAddress: "0.0.0.0:8080",
+				GinServerAddress:  "0.0.0.0:8081",
+				RunGinServer:      true,
+				RunGrpcServer:     true,
+			},
+			valid: true,
+		},
+		{
+			name: "Gin Server Only",
+			config: Config{
+				HttpServerAddress: "0.0.0.0:8080",
+				GinServerAddress:  "0.0.0.0:8080",
+				RunGinServer:      true,
+			},
+			valid: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestLoadConfigFromEnv(t *testing.T) {
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "app.env"), []byte("HTTP_SERVER_ADDRESS=0.0.0.0:8080\n"), 0o600)
+	require.NoError(t, err)
+
+	t.Setenv("TOKEN_KEY_ID", "key-1")
+	t.Setenv("TOKEN_SECRET_KEY", "secret")
+	t.Setenv("TOKEN_PUBLIC_KEYS", "key-0:public")
+	t.Setenv("SMTP_HOST", "smtp.example.com")
+	t.Setenv("SMTP_USERNAME", "mailer")
+	t.Setenv("SMTP_PASSWORD", "password")
+
+	config, err := LoadConfig(dir)
+	require.NoError(t, err)
+	require.Equal(t, "key-1", config.TokenKeyID)
+	require.Equal(t, "secret", config.TokenSecretKey)
+	require.Equal(t, "key-0:public", config.TokenPublicKeys)
+	require.Equal(t, "smtp.example.com", config.SMTPHost)
+	require.Equal(t, "mailer", config.SMTPUsername)
+	require.Equal(t, "password", config.SMTPPassword)
+}
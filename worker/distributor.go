@@ -2,25 +2,92 @@ package worker
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
 	"github.com/hibiken/asynq"
 )
 
 type TaskDistributor interface {
+	// DistributeTask enqueues a task whose payload is already encoded, the outbox relay uses it
+	DistributeTask(
+		context context.Context,
+		taskType string,
+		payload []byte,
+		opts ...asynq.Option,
+	) error
 	DistributeTaskSendEmailVerify(
 		context context.Context,
 		payload *PayloadSendEmailVerify,
 		opts ...asynq.Option,
 	) error
+	DistributeTaskSendTicketPurchased(
+		context context.Context,
+		payload *PayloadSendTicketPurchased,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendTicketCancelled(
+		context context.Context,
+		payload *PayloadSendTicketCancelled,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendHostRequestDecision(
+		context context.Context,
+		payload *PayloadSendHostRequestDecision,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendEventChanged(
+		context context.Context,
+		payload *PayloadSendEventChanged,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendEventCancelled(
+		context context.Context,
+		payload *PayloadSendEventCancelled,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendEventReminder(
+		context context.Context,
+		payload *PayloadSendEventReminder,
+		opts ...asynq.Option,
+	) error
+}
+
+// payloadDistributor implements the typed DistributeTask* methods on top of
+// distribute, so that every TaskDistributor only has to enqueue encoded tasks
+type payloadDistributor struct {
+	distribute func(context context.Context, taskType string, payload []byte, opts ...asynq.Option) error
+}
+
+// enqueue marshals the payload to json and enqueues it as a task of the given type
+func (d *payloadDistributor) enqueue(context context.Context, taskType string, payload any, opts ...asynq.Option) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("could not marshal payload: %w", err)
+	}
+
+	return d.distribute(context, taskType, jsonPayload, opts...)
 }
 
 type RedisTaskDistributor struct {
+	payloadDistributor
 	client *asynq.Client
 }
 
 func NewRedisTaskDistributor(redisOpt asynq.RedisClientOpt) TaskDistributor {
 	client := asynq.NewClient(redisOpt)
-	return &RedisTaskDistributor{
+	distributor := &RedisTaskDistributor{
 		client: client,
 	}
+	distributor.distribute = distributor.DistributeTask
+	return distributor
+}
+
+func (d *RedisTaskDistributor) DistributeTask(context context.Context, taskType string, payload []byte, opts ...asynq.Option) error {
+	task := asynq.NewTask(taskType, payload, opts...)
+	_, err := d.client.EnqueueContext(context, task)
+	if err != nil {
+		return fmt.Errorf("could not enqueue task: %w", err)
+	}
+	return nil
 }
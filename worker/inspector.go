@@ -0,0 +1,83 @@
+package worker
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+)
+
+// ErrTaskNotFailed is returned when retrying or deleting a task that is not
+// waiting for a retry or archived
+var ErrTaskNotFailed = errors.New("task has not failed")
+
+// TaskInspector lets admins look at the tasks that failed and replay or drop
+// them. Missing queues and tasks are reported with asynq.ErrQueueNotFound and
+// asynq.ErrTaskNotFound.
+type TaskInspector interface {
+	// ListFailedTasks lists the tasks of a queue in the retry or the archived
+	// state, page starts at 1
+	ListFailedTasks(queue string, state asynq.TaskState, page int, pageSize int) ([]*asynq.TaskInfo, error)
+	GetTask(queue string, taskID string) (*asynq.TaskInfo, error)
+	// RetryTask runs a failed task again right away
+	RetryTask(queue string, taskID string) error
+	// DeleteTask drops a failed task for good
+	DeleteTask(queue string, taskID string) error
+}
+
+type RedisTaskInspector struct {
+	inspector *asynq.Inspector
+}
+
+func NewRedisTaskInspector(redisOpt asynq.RedisClientOpt) TaskInspector {
+	return &RedisTaskInspector{
+		inspector: asynq.NewInspector(redisOpt),
+	}
+}
+
+func (i *RedisTaskInspector) ListFailedTasks(queue string, state asynq.TaskState, page int, pageSize int) ([]*asynq.TaskInfo, error) {
+	opts := []asynq.ListOption{asynq.Page(page), asynq.PageSize(pageSize)}
+
+	switch state {
+	case asynq.TaskStateRetry:
+		return i.inspector.ListRetryTasks(queue, opts...)
+	case asynq.TaskStateArchived:
+		return i.inspector.ListArchivedTasks(queue, opts...)
+	default:
+		return nil, fmt.Errorf("cannot list %s tasks", state)
+	}
+}
+
+func (i *RedisTaskInspector) GetTask(queue string, taskID string) (*asynq.TaskInfo, error) {
+	return i.inspector.GetTaskInfo(queue, taskID)
+}
+
+func (i *RedisTaskInspector) RetryTask(queue string, taskID string) error {
+	if err := i.checkFailed(queue, taskID); err != nil {
+		return err
+	}
+	return i.inspector.RunTask(queue, taskID)
+}
+
+func (i *RedisTaskInspector) DeleteTask(queue string, taskID string) error {
+	if err := i.checkFailed(queue, taskID); err != nil {
+		return err
+	}
+	return i.inspector.DeleteTask(queue, taskID)
+}
+
+// checkFailed keeps the inspector from touching tasks that are pending or running
+func (i *RedisTaskInspector) checkFailed(queue string, taskID string) error {
+	info, err := i.inspector.GetTaskInfo(queue, taskID)
+	if err != nil {
+		return err
+	}
+	if !isFailed(info.State) {
+		return ErrTaskNotFailed
+	}
+	return nil
+}
+
+func isFailed(state asynq.TaskState) bool {
+	return state == asynq.TaskStateRetry || state == asynq.TaskStateArchived
+}
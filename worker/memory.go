@@ -0,0 +1,592 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/yashagw/event-management-api/db"
+	"github.com/yashagw/event-management-api/mail"
+	"github.com/yashagw/event-management-api/util"
+)
+
+const (
+	// BrokerRedis queues tasks in redis through asynq
+	BrokerRedis = "redis"
+	// BrokerMemory queues tasks in the memory of the process
+	BrokerMemory = "memory"
+)
+
+const (
+	// memoryTaskTimeout is used when a task has neither a timeout nor a deadline, like asynq
+	memoryTaskTimeout = 30 * time.Minute
+	// memoryHistorySize is how many completed and archived tasks the broker remembers
+	memoryHistorySize = 1000
+)
+
+// MemoryBroker keeps tasks in the memory of the process. It connects a
+// MemoryTaskDistributor to a MemoryTaskProcessor running in the same binary,
+// tasks that are still queued when the process exits are lost.
+type MemoryBroker struct {
+	mu sync.Mutex
+	// changed is closed and replaced whenever a task is queued or finished
+	changed chan struct{}
+	queues  map[string][]*memoryTask
+	active  int
+	// ids blocks a task id until the time it maps to, zero blocks it for good
+	ids       map[string]time.Time
+	lastPrune time.Time
+	completed []*asynq.TaskInfo
+	archived  []*asynq.TaskInfo
+	// retryDelay is replaced in tests
+	retryDelay asynq.RetryDelayFunc
+}
+
+type memoryTask struct {
+	task *asynq.Task
+	info asynq.TaskInfo
+}
+
+func NewMemoryBroker() *MemoryBroker {
+	return &MemoryBroker{
+		changed:    make(chan struct{}),
+		queues:     make(map[string][]*memoryTask),
+		ids:        make(map[string]time.Time),
+		retryDelay: asynq.DefaultRetryDelayFunc,
+	}
+}
+
+// enqueue queues a task with the asynq options that make sense in process,
+// Unique and Group are ignored.
+func (b *MemoryBroker) enqueue(taskType string, payload []byte, opts ...asynq.Option) (*asynq.TaskInfo, error) {
+	current := time.Now()
+	info := asynq.TaskInfo{
+		ID:            uuid.NewString(),
+		Queue:         QueueDefault,
+		Type:          taskType,
+		Payload:       payload,
+		State:         asynq.TaskStatePending,
+		MaxRetry:      defaultMaxRetry,
+		NextProcessAt: current,
+	}
+	for _, opt := range opts {
+		switch opt.Type() {
+		case asynq.QueueOpt:
+			info.Queue = opt.Value().(string)
+		case asynq.MaxRetryOpt:
+			info.MaxRetry = opt.Value().(int)
+		case asynq.TimeoutOpt:
+			info.Timeout = opt.Value().(time.Duration)
+		case asynq.DeadlineOpt:
+			info.Deadline = opt.Value().(time.Time)
+		case asynq.TaskIDOpt:
+			info.ID = opt.Value().(string)
+		case asynq.ProcessAtOpt:
+			info.NextProcessAt = opt.Value().(time.Time)
+		case asynq.ProcessInOpt:
+			info.NextProcessAt = current.Add(opt.Value().(time.Duration))
+		case asynq.RetentionOpt:
+			info.Retention = opt.Value().(time.Duration)
+		}
+	}
+	if info.Queue == "" {
+		return nil, errors.New("queue name cannot be empty")
+	}
+	if info.NextProcessAt.After(current) {
+		info.State = asynq.TaskStateScheduled
+	}
+	if info.Timeout == 0 && info.Deadline.IsZero() {
+		info.Timeout = memoryTaskTimeout
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.pruneIDs(current)
+	if until, ok := b.ids[info.ID]; ok && (until.IsZero() || until.After(current)) {
+		return nil, asynq.ErrTaskIDConflict
+	}
+	b.ids[info.ID] = time.Time{}
+
+	b.queues[info.Queue] = append(b.queues[info.Queue], &memoryTask{
+		task: asynq.NewTask(taskType, payload),
+		info: info,
+	})
+	b.notify()
+
+	result := info
+	return &result, nil
+}
+
+// pruneIDs forgets the ids of tasks whose retention has passed, at most once a minute
+func (b *MemoryBroker) pruneIDs(current time.Time) {
+	if current.Sub(b.lastPrune) < time.Minute {
+		return
+	}
+	b.lastPrune = current
+
+	for id, until := range b.ids {
+		if !until.IsZero() && !until.After(current) {
+			delete(b.ids, id)
+		}
+	}
+}
+
+// dequeue takes the next task that is due, it looks at the queues from the
+// highest priority down and only moves on once a queue has nothing due. When no
+// task is due it returns how long until the next one is, or zero if there is none.
+func (b *MemoryBroker) dequeue(current time.Time) (*memoryTask, time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var wait time.Duration
+	for _, queue := range prioritizedQueues() {
+		tasks := b.queues[queue]
+		for i, t := range tasks {
+			if !t.info.NextProcessAt.After(current) {
+				b.queues[queue] = append(tasks[:i:i], tasks[i+1:]...)
+				t.info.State = asynq.TaskStateActive
+				b.active++
+				return t, 0
+			}
+
+			if until := t.info.NextProcessAt.Sub(current); wait == 0 || until < wait {
+				wait = until
+			}
+		}
+	}
+
+	return nil, wait
+}
+
+// finish records the result of an attempt, a failed task is queued again
+// after a backoff until it runs out of retries and is archived
+func (b *MemoryBroker) finish(t *memoryTask, err error) {
+	current := time.Now()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.active--
+	defer b.notify()
+
+	if err == nil {
+		t.info.State = asynq.TaskStateCompleted
+		t.info.CompletedAt = current
+		t.info.NextProcessAt = time.Time{}
+		if t.info.Retention > 0 {
+			b.ids[t.info.ID] = current.Add(t.info.Retention)
+		} else {
+			delete(b.ids, t.info.ID)
+		}
+		b.completed = appendHistory(b.completed, t.info)
+		return
+	}
+
+	t.info.LastErr = err.Error()
+	t.info.LastFailedAt = current
+	if t.info.Retried >= t.info.MaxRetry || errors.Is(err, asynq.SkipRetry) {
+		log.Printf("task %s (%s) archived: %v", t.info.ID, t.info.Type, err)
+		t.info.State = asynq.TaskStateArchived
+		t.info.NextProcessAt = time.Time{}
+		if len(b.archived) == memoryHistorySize {
+			// The oldest archived task is forgotten, so is its id
+			delete(b.ids, b.archived[0].ID)
+		}
+		b.archived = appendHistory(b.archived, t.info)
+		return
+	}
+
+	log.Printf("task %s (%s) failed, retrying: %v", t.info.ID, t.info.Type, err)
+	t.info.Retried++
+	t.info.State = asynq.TaskStateRetry
+	t.info.NextProcessAt = current.Add(b.retryDelay(t.info.Retried, err, t.task))
+	b.queues[t.info.Queue] = append(b.queues[t.info.Queue], t)
+}
+
+// notify wakes up everyone waiting for a change, b.mu must be held
+func (b *MemoryBroker) notify() {
+	close(b.changed)
+	b.changed = make(chan struct{})
+}
+
+// Completed returns the tasks that ran successfully, oldest first
+func (b *MemoryBroker) Completed() []*asynq.TaskInfo {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return append([]*asynq.TaskInfo(nil), b.completed...)
+}
+
+// Archived returns the tasks that ran out of retries or skipped them, oldest first
+func (b *MemoryBroker) Archived() []*asynq.TaskInfo {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return append([]*asynq.TaskInfo(nil), b.archived...)
+}
+
+// WaitIdle blocks until no task is queued, waiting for a retry or running
+func (b *MemoryBroker) WaitIdle(ctx context.Context) error {
+	for {
+		b.mu.Lock()
+		idle := b.active == 0
+		for _, tasks := range b.queues {
+			idle = idle && len(tasks) == 0
+		}
+		changed := b.changed
+		b.mu.Unlock()
+
+		if idle {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+func appendHistory(history []*asynq.TaskInfo, info asynq.TaskInfo) []*asynq.TaskInfo {
+	if len(history) == memoryHistorySize {
+		history = history[1:]
+	}
+	return append(history, &info)
+}
+
+// prioritizedQueues returns the queues the processors consume, highest priority first
+func prioritizedQueues() []string {
+	queues := make([]string, 0, len(queuePriorities))
+	for queue := range queuePriorities {
+		queues = append(queues, queue)
+	}
+	sort.Slice(queues, func(i, j int) bool {
+		return queuePriorities[queues[i]] > queuePriorities[queues[j]]
+	})
+	return queues
+}
+
+type MemoryTaskDistributor struct {
+	payloadDistributor
+	broker *MemoryBroker
+}
+
+func NewMemoryTaskDistributor(broker *MemoryBroker) TaskDistributor {
+	distributor := &MemoryTaskDistributor{
+		broker: broker,
+	}
+	distributor.distribute = distributor.DistributeTask
+	return distributor
+}
+
+func (d *MemoryTaskDistributor) DistributeTask(context context.Context, taskType string, payload []byte, opts ...asynq.Option) error {
+	_, err := d.broker.enqueue(taskType, payload, opts...)
+	if err != nil {
+		return fmt.Errorf("could not enqueue task: %w", err)
+	}
+	return nil
+}
+
+// MemoryTaskInspector inspects the failed tasks of a MemoryBroker, only the
+// most recent archived tasks are kept
+type MemoryTaskInspector struct {
+	broker *MemoryBroker
+}
+
+func NewMemoryTaskInspector(broker *MemoryBroker) TaskInspector {
+	return &MemoryTaskInspector{
+		broker: broker,
+	}
+}
+
+// ListFailedTasks lists retries by when they are due and archived tasks newest first, like asynq
+func (i *MemoryTaskInspector) ListFailedTasks(queue string, state asynq.TaskState, page int, pageSize int) ([]*asynq.TaskInfo, error) {
+	b := i.broker
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if err := b.checkQueue(queue); err != nil {
+		return nil, err
+	}
+
+	var tasks []*asynq.TaskInfo
+	switch state {
+	case asynq.TaskStateRetry:
+		for _, t := range b.queues[queue] {
+			if t.info.State == asynq.TaskStateRetry {
+				info := t.info
+				tasks = append(tasks, &info)
+			}
+		}
+		sort.SliceStable(tasks, func(i, j int) bool {
+			return tasks[i].NextProcessAt.Before(tasks[j].NextProcessAt)
+		})
+	case asynq.TaskStateArchived:
+		for k := len(b.archived) - 1; k >= 0; k-- {
+			if b.archived[k].Queue == queue {
+				info := *b.archived[k]
+				tasks = append(tasks, &info)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("cannot list %s tasks", state)
+	}
+
+	start := (page - 1) * pageSize
+	if page < 1 || start >= len(tasks) {
+		return nil, nil
+	}
+	end := start + pageSize
+	if end > len(tasks) {
+		end = len(tasks)
+	}
+	return tasks[start:end], nil
+}
+
+func (i *MemoryTaskInspector) GetTask(queue string, taskID string) (*asynq.TaskInfo, error) {
+	b := i.broker
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if err := b.checkQueue(queue); err != nil {
+		return nil, err
+	}
+
+	for _, t := range b.queues[queue] {
+		if t.info.ID == taskID {
+			info := t.info
+			return &info, nil
+		}
+	}
+	for _, history := range [][]*asynq.TaskInfo{b.archived, b.completed} {
+		for _, stored := range history {
+			if stored.Queue == queue && stored.ID == taskID {
+				info := *stored
+				return &info, nil
+			}
+		}
+	}
+	return nil, asynq.ErrTaskNotFound
+}
+
+func (i *MemoryTaskInspector) RetryTask(queue string, taskID string) error {
+	b := i.broker
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	t, k, err := b.removeFailed(queue, taskID)
+	if err != nil {
+		return err
+	}
+	if k >= 0 {
+		b.archived = append(b.archived[:k:k], b.archived[k+1:]...)
+	}
+
+	t.info.State = asynq.TaskStatePending
+	t.info.NextProcessAt = time.Now()
+	b.queues[queue] = append(b.queues[queue], t)
+	b.notify()
+	return nil
+}
+
+func (i *MemoryTaskInspector) DeleteTask(queue string, taskID string) error {
+	b := i.broker
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	t, k, err := b.removeFailed(queue, taskID)
+	if err != nil {
+		return err
+	}
+	if k >= 0 {
+		b.archived = append(b.archived[:k:k], b.archived[k+1:]...)
+	}
+
+	delete(b.ids, t.info.ID)
+	b.notify()
+	return nil
+}
+
+// removeFailed takes a retry out of its queue, or finds an archived task and
+// returns its index in b.archived, -1 otherwise. b.mu must be held.
+func (b *MemoryBroker) removeFailed(queue string, taskID string) (*memoryTask, int, error) {
+	if err := b.checkQueue(queue); err != nil {
+		return nil, -1, err
+	}
+
+	tasks := b.queues[queue]
+	for k, t := range tasks {
+		if t.info.ID != taskID {
+			continue
+		}
+		if t.info.State != asynq.TaskStateRetry {
+			return nil, -1, ErrTaskNotFailed
+		}
+		b.queues[queue] = append(tasks[:k:k], tasks[k+1:]...)
+		return t, -1, nil
+	}
+
+	for k, info := range b.archived {
+		if info.Queue == queue && info.ID == taskID {
+			return &memoryTask{
+				task: asynq.NewTask(info.Type, info.Payload),
+				info: *info,
+			}, k, nil
+		}
+	}
+
+	for _, info := range b.completed {
+		if info.Queue == queue && info.ID == taskID {
+			return nil, -1, ErrTaskNotFailed
+		}
+	}
+	return nil, -1, asynq.ErrTaskNotFound
+}
+
+// checkQueue reports the queues that are neither consumed nor hold a task as missing
+func (b *MemoryBroker) checkQueue(queue string) error {
+	if _, ok := queuePriorities[queue]; ok {
+		return nil
+	}
+	if _, ok := b.queues[queue]; ok {
+		return nil
+	}
+	return asynq.ErrQueueNotFound
+}
+
+// MemoryTaskProcessor runs the tasks of a MemoryBroker with the same handlers
+// as RedisTaskProcessor
+type MemoryTaskProcessor struct {
+	taskHandlers
+	broker      *MemoryBroker
+	handler     asynq.Handler
+	concurrency int
+	stop        chan struct{}
+	done        chan struct{}
+}
+
+func NewMemoryTaskProcessor(
+	broker *MemoryBroker,
+	provider db.Provider,
+	config util.Config,
+	mailer mail.EmailSender,
+) TaskProcessor {
+	processor := &MemoryTaskProcessor{
+		taskHandlers: taskHandlers{
+			provider: provider,
+			config:   config,
+			mailer:   mailer,
+		},
+		broker:      broker,
+		concurrency: runtime.NumCPU(),
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+	processor.handler = processor.serveMux()
+	return processor
+}
+
+// Start runs tasks in the background until Shutdown is called
+func (p *MemoryTaskProcessor) Start() error {
+	go func() {
+		defer close(p.done)
+
+		var wg sync.WaitGroup
+		defer wg.Wait()
+
+		workers := make(chan struct{}, p.concurrency)
+		for {
+			select {
+			case <-p.stop:
+				return
+			case workers <- struct{}{}:
+			}
+
+			t := p.next()
+			if t == nil {
+				return
+			}
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer func() { <-workers }()
+				p.broker.finish(t, p.process(t))
+			}()
+		}
+	}()
+
+	return nil
+}
+
+// next waits for a task to become due, it returns nil once the processor is stopped
+func (p *MemoryTaskProcessor) next() *memoryTask {
+	for {
+		p.broker.mu.Lock()
+		changed := p.broker.changed
+		p.broker.mu.Unlock()
+
+		t, wait := p.broker.dequeue(time.Now())
+		if t != nil {
+			return t
+		}
+
+		var due <-chan time.Time
+		var timer *time.Timer
+		if wait > 0 {
+			timer = time.NewTimer(wait)
+			due = timer.C
+		}
+
+		select {
+		case <-p.stop:
+		case <-changed:
+		case <-due:
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+
+		select {
+		case <-p.stop:
+			return nil
+		default:
+		}
+	}
+}
+
+func (p *MemoryTaskProcessor) process(t *memoryTask) (err error) {
+	ctx := context.Background()
+	var cancel context.CancelFunc
+	if t.info.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, t.info.Timeout)
+		defer cancel()
+	}
+	if !t.info.Deadline.IsZero() {
+		ctx, cancel = context.WithDeadline(ctx, t.info.Deadline)
+		defer cancel()
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	return p.handler.ProcessTask(ctx, t.task)
+}
+
+// Shutdown stops taking tasks and waits for the running ones to finish
+func (p *MemoryTaskProcessor) Shutdown() {
+	close(p.stop)
+	<-p.done
+}
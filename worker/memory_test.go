@@ -0,0 +1,264 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yashagw/event-management-api/db/mock"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/mail"
+	"github.com/yashagw/event-management-api/util"
+)
+
+type recordingMailer struct {
+	mu     sync.Mutex
+	emails []*mail.Email
+}
+
+func (m *recordingMailer) SendEmail(email *mail.Email) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.emails = append(m.emails, email)
+	return nil
+}
+
+// startMemoryProcessor runs handler on the tasks of a new broker with a single
+// worker and quick retries
+func startMemoryProcessor(t *testing.T, handler asynq.HandlerFunc) (*MemoryBroker, TaskDistributor) {
+	broker := NewMemoryBroker()
+	broker.retryDelay = func(n int, e error, t *asynq.Task) time.Duration {
+		return time.Millisecond
+	}
+
+	processor := NewMemoryTaskProcessor(broker, nil, util.Config{}, nil).(*MemoryTaskProcessor)
+	processor.handler = handler
+	processor.concurrency = 1
+	require.NoError(t, processor.Start())
+	t.Cleanup(processor.Shutdown)
+
+	return broker, NewMemoryTaskDistributor(broker)
+}
+
+func waitIdle(t *testing.T, broker *MemoryBroker) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, broker.WaitIdle(ctx))
+}
+
+func TestMemoryTaskProcessor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	provider := mockdb.NewMockProvider(ctrl)
+	mailer := &recordingMailer{}
+
+	user := &model.User{ID: 1, Name: "Jane", Email: "jane@example.com"}
+	ticket := &model.Ticket{ID: 7, UserID: 1, EventID: 3, Quantity: 2}
+	event := &model.Event{ID: 3, Name: "Rock Night", StartDate: time.Now(), EndDate: time.Now().Add(time.Hour)}
+	provider.EXPECT().GetUserByID(gomock.Any(), user.ID).Times(1).Return(user, nil)
+	provider.EXPECT().GetTicket(gomock.Any(), model.GetTicketParams{TicketID: ticket.ID, UserID: user.ID}).Times(1).Return(ticket, nil)
+	provider.EXPECT().GetEvent(gomock.Any(), model.GetEventParams{EventID: event.ID}).Times(1).Return(event, nil)
+
+	broker := NewMemoryBroker()
+	processor := NewMemoryTaskProcessor(broker, provider, util.Config{}, mailer)
+	require.NoError(t, processor.Start())
+	defer processor.Shutdown()
+
+	distributor := NewMemoryTaskDistributor(broker)
+	err := distributor.DistributeTaskSendTicketPurchased(context.Background(), &PayloadSendTicketPurchased{
+		UserID:   user.ID,
+		TicketID: ticket.ID,
+	}, asynq.Queue(QueueCritical))
+	require.NoError(t, err)
+
+	waitIdle(t, broker)
+
+	completed := broker.Completed()
+	require.Len(t, completed, 1)
+	require.Equal(t, TaskSendTicketPurchased, completed[0].Type)
+	require.Equal(t, QueueCritical, completed[0].Queue)
+	require.Equal(t, asynq.TaskStateCompleted, completed[0].State)
+	require.Empty(t, broker.Archived())
+
+	require.Len(t, mailer.emails, 1)
+	require.Equal(t, []string{user.Email}, mailer.emails[0].To)
+}
+
+func TestMemoryTaskProcessorRetry(t *testing.T) {
+	var mu sync.Mutex
+	attempts := map[string]int{}
+	broker, distributor := startMemoryProcessor(t, func(ctx context.Context, task *asynq.Task) error {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts[task.Type()]++
+
+		switch task.Type() {
+		case "flaky":
+			if attempts["flaky"] < 3 {
+				return errors.New("not yet")
+			}
+			return nil
+		case "skip":
+			return asynq.SkipRetry
+		default:
+			return errors.New("always fails")
+		}
+	})
+
+	require.NoError(t, distributor.DistributeTask(context.Background(), "flaky", nil))
+	require.NoError(t, distributor.DistributeTask(context.Background(), "broken", nil, asynq.MaxRetry(2)))
+	require.NoError(t, distributor.DistributeTask(context.Background(), "skip", nil))
+	waitIdle(t, broker)
+
+	completed := broker.Completed()
+	require.Len(t, completed, 1)
+	require.Equal(t, "flaky", completed[0].Type)
+	require.Equal(t, 2, completed[0].Retried)
+
+	archived := broker.Archived()
+	require.Len(t, archived, 2)
+	for _, info := range archived {
+		require.Equal(t, asynq.TaskStateArchived, info.State)
+		switch info.Type {
+		case "broken":
+			require.Equal(t, 2, info.Retried)
+			require.Equal(t, "always fails", info.LastErr)
+		case "skip":
+			require.Zero(t, info.Retried)
+		default:
+			t.Fatalf("unexpected archived task %s", info.Type)
+		}
+	}
+	require.Equal(t, 3, attempts["broken"])
+	require.Equal(t, 1, attempts["skip"])
+}
+
+func TestMemoryTaskProcessorTimeout(t *testing.T) {
+	broker, distributor := startMemoryProcessor(t, func(ctx context.Context, task *asynq.Task) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	err := distributor.DistributeTask(context.Background(), "slow", nil, asynq.Timeout(10*time.Millisecond), asynq.MaxRetry(0))
+	require.NoError(t, err)
+	waitIdle(t, broker)
+
+	archived := broker.Archived()
+	require.Len(t, archived, 1)
+	require.Equal(t, context.DeadlineExceeded.Error(), archived[0].LastErr)
+}
+
+func TestMemoryTaskProcessorPriority(t *testing.T) {
+	broker := NewMemoryBroker()
+	distributor := NewMemoryTaskDistributor(broker)
+
+	// Queued before the processor starts, so the critical task is waiting too
+	require.NoError(t, distributor.DistributeTask(context.Background(), "default", nil, asynq.Queue(QueueDefault)))
+	require.NoError(t, distributor.DistributeTask(context.Background(), "critical", nil, asynq.Queue(QueueCritical)))
+	require.NoError(t, distributor.DistributeTask(context.Background(), "later", nil, asynq.ProcessIn(50*time.Millisecond)))
+
+	var mu sync.Mutex
+	var order []string
+	processor := NewMemoryTaskProcessor(broker, nil, util.Config{}, nil).(*MemoryTaskProcessor)
+	processor.concurrency = 1
+	processor.handler = asynq.HandlerFunc(func(ctx context.Context, task *asynq.Task) error {
+		mu.Lock()
+		defer mu.Unlock()
+		order = append(order, task.Type())
+		return nil
+	})
+	require.NoError(t, processor.Start())
+	defer processor.Shutdown()
+
+	waitIdle(t, broker)
+	require.Equal(t, []string{"critical", "default", "later"}, order)
+}
+
+func TestMemoryTaskDistributorTaskID(t *testing.T) {
+	broker, distributor := startMemoryProcessor(t, func(ctx context.Context, task *asynq.Task) error {
+		return nil
+	})
+
+	err := distributor.DistributeTask(context.Background(), "once", nil, asynq.TaskID("task-id"), asynq.ProcessIn(time.Hour))
+	require.NoError(t, err)
+
+	err = distributor.DistributeTask(context.Background(), "once", nil, asynq.TaskID("task-id"))
+	require.ErrorIs(t, err, asynq.ErrTaskIDConflict)
+
+	err = distributor.DistributeTask(context.Background(), "once", nil, asynq.Queue(""))
+	require.Error(t, err)
+
+	// A completed task keeps its id for as long as it is retained
+	err = distributor.DistributeTask(context.Background(), "retained", nil, asynq.TaskID("retained"), asynq.Retention(time.Hour))
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return len(broker.Completed()) == 1 }, 5*time.Second, time.Millisecond)
+
+	err = distributor.DistributeTask(context.Background(), "retained", nil, asynq.TaskID("retained"))
+	require.ErrorIs(t, err, asynq.ErrTaskIDConflict)
+}
+
+func TestMemoryTaskInspector(t *testing.T) {
+	var mu sync.Mutex
+	fail := true
+	broker, distributor := startMemoryProcessor(t, func(ctx context.Context, task *asynq.Task) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if fail {
+			return errors.New("smtp is down")
+		}
+		return nil
+	})
+	inspector := NewMemoryTaskInspector(broker)
+
+	for _, id := range []string{"first", "second"} {
+		err := distributor.DistributeTask(context.Background(), TaskSendVerifyEmail, []byte(`{}`),
+			asynq.TaskID(id), asynq.Queue(QueueCritical), asynq.MaxRetry(0))
+		require.NoError(t, err)
+	}
+	waitIdle(t, broker)
+
+	tasks, err := inspector.ListFailedTasks(QueueCritical, asynq.TaskStateArchived, 1, 1)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	require.Equal(t, "second", tasks[0].ID)
+	require.Equal(t, "smtp is down", tasks[0].LastErr)
+
+	tasks, err = inspector.ListFailedTasks(QueueCritical, asynq.TaskStateArchived, 2, 1)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	require.Equal(t, "first", tasks[0].ID)
+
+	tasks, err = inspector.ListFailedTasks(QueueDefault, asynq.TaskStateRetry, 1, 10)
+	require.NoError(t, err)
+	require.Empty(t, tasks)
+
+	_, err = inspector.ListFailedTasks("unknown", asynq.TaskStateArchived, 1, 10)
+	require.ErrorIs(t, err, asynq.ErrQueueNotFound)
+
+	_, err = inspector.GetTask(QueueCritical, "missing")
+	require.ErrorIs(t, err, asynq.ErrTaskNotFound)
+
+	mu.Lock()
+	fail = false
+	mu.Unlock()
+
+	require.NoError(t, inspector.RetryTask(QueueCritical, "first"))
+	require.NoError(t, inspector.DeleteTask(QueueCritical, "second"))
+	waitIdle(t, broker)
+
+	info, err := inspector.GetTask(QueueCritical, "first")
+	require.NoError(t, err)
+	require.Equal(t, asynq.TaskStateCompleted, info.State)
+
+	_, err = inspector.GetTask(QueueCritical, "second")
+	require.ErrorIs(t, err, asynq.ErrTaskNotFound)
+	require.Empty(t, broker.Archived())
+
+	// Completed tasks cannot be replayed, and deleted ids are free again
+	require.ErrorIs(t, inspector.RetryTask(QueueCritical, "first"), ErrTaskNotFailed)
+	require.NoError(t, distributor.DistributeTask(context.Background(), TaskSendVerifyEmail, nil, asynq.TaskID("second")))
+}
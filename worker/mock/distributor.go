@@ -36,6 +36,25 @@ func (m *MockTaskDistributor) EXPECT() *MockTaskDistributorMockRecorder {
 	return m.recorder
 }
 
+// DistributeTask mocks base method.
+func (m *MockTaskDistributor) DistributeTask(arg0 context.Context, arg1 string, arg2 []byte, arg3 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTask", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTask indicates an expected call of DistributeTask.
+func (mr *MockTaskDistributorMockRecorder) DistributeTask(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTask", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTask), varargs...)
+}
+
 // DistributeTaskSendEmailVerify mocks base method.
 func (m *MockTaskDistributor) DistributeTaskSendEmailVerify(arg0 context.Context, arg1 *worker.PayloadSendEmailVerify, arg2 ...asynq.Option) error {
 	m.ctrl.T.Helper()
@@ -54,3 +73,117 @@ func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendEmailVerify(arg0, a
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendEmailVerify", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendEmailVerify), varargs...)
 }
+
+// DistributeTaskSendEventCancelled mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSendEventCancelled(arg0 context.Context, arg1 *worker.PayloadSendEventCancelled, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendEventCancelled", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendEventCancelled indicates an expected call of DistributeTaskSendEventCancelled.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendEventCancelled(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendEventCancelled", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendEventCancelled), varargs...)
+}
+
+// DistributeTaskSendEventChanged mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSendEventChanged(arg0 context.Context, arg1 *worker.PayloadSendEventChanged, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendEventChanged", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendEventChanged indicates an expected call of DistributeTaskSendEventChanged.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendEventChanged(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendEventChanged", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendEventChanged), varargs...)
+}
+
+// DistributeTaskSendEventReminder mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSendEventReminder(arg0 context.Context, arg1 *worker.PayloadSendEventReminder, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendEventReminder", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendEventReminder indicates an expected call of DistributeTaskSendEventReminder.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendEventReminder(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendEventReminder", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendEventReminder), varargs...)
+}
+
+// DistributeTaskSendHostRequestDecision mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSendHostRequestDecision(arg0 context.Context, arg1 *worker.PayloadSendHostRequestDecision, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendHostRequestDecision", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendHostRequestDecision indicates an expected call of DistributeTaskSendHostRequestDecision.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendHostRequestDecision(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendHostRequestDecision", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendHostRequestDecision), varargs...)
+}
+
+// DistributeTaskSendTicketCancelled mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSendTicketCancelled(arg0 context.Context, arg1 *worker.PayloadSendTicketCancelled, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendTicketCancelled", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendTicketCancelled indicates an expected call of DistributeTaskSendTicketCancelled.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendTicketCancelled(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendTicketCancelled", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendTicketCancelled), varargs...)
+}
+
+// DistributeTaskSendTicketPurchased mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSendTicketPurchased(arg0 context.Context, arg1 *worker.PayloadSendTicketPurchased, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendTicketPurchased", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendTicketPurchased indicates an expected call of DistributeTaskSendTicketPurchased.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendTicketPurchased(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendTicketPurchased", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendTicketPurchased), varargs...)
+}
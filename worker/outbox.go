@@ -0,0 +1,183 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/yashagw/event-management-api/db"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/util"
+)
+
+// asynq retries a task 25 times unless told otherwise
+const defaultMaxRetry = 25
+
+// outboxTaskRetention keeps completed tasks in redis so that a message relayed
+// twice within this window is rejected by its task id instead of running again
+const outboxTaskRetention = 24 * time.Hour
+
+const (
+	// outboxMaxAttempts is how many times a message is relayed before it is left
+	// in the outbox for an operator to look at
+	outboxMaxAttempts = 20
+	// outboxRetryDelay doubles after every failed attempt up to outboxMaxRetryDelay
+	outboxRetryDelay    = time.Second
+	outboxMaxRetryDelay = time.Hour
+)
+
+// NewOutboxMessage encodes a task so that it can be written to the outbox in the
+// same transaction as the change that caused it. Only the Queue, MaxRetry, Timeout
+// and TaskID options are supported, a random task id is used when none is given.
+func NewOutboxMessage(taskType string, payload any, opts ...asynq.Option) (model.CreateOutboxMessageParams, error) {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return model.CreateOutboxMessageParams{}, fmt.Errorf("could not marshal payload: %w", err)
+	}
+
+	message := model.CreateOutboxMessageParams{
+		TaskID:   uuid.NewString(),
+		TaskType: taskType,
+		Payload:  jsonPayload,
+		Queue:    QueueDefault,
+		MaxRetry: defaultMaxRetry,
+	}
+	for _, opt := range opts {
+		switch opt.Type() {
+		case asynq.QueueOpt:
+			message.Queue = opt.Value().(string)
+		case asynq.MaxRetryOpt:
+			message.MaxRetry = opt.Value().(int)
+		case asynq.TimeoutOpt:
+			message.Timeout = opt.Value().(time.Duration)
+		case asynq.TaskIDOpt:
+			message.TaskID = opt.Value().(string)
+		default:
+			return model.CreateOutboxMessageParams{}, fmt.Errorf("option %s is not supported by the outbox", opt)
+		}
+	}
+
+	return message, nil
+}
+
+// OutboxRelay publishes the messages written to the outbox to the task queue.
+// Delivery is at least once, a message that was enqueued but not yet marked as
+// dispatched is enqueued again under the same task id, which asynq rejects.
+type OutboxRelay struct {
+	provider    db.Provider
+	distributor TaskDistributor
+	interval    time.Duration
+	batchSize   int
+	stop        chan struct{}
+	done        chan struct{}
+}
+
+func NewOutboxRelay(provider db.Provider, distributor TaskDistributor, config util.Config) *OutboxRelay {
+	return &OutboxRelay{
+		provider:    provider,
+		distributor: distributor,
+		interval:    config.OutboxRelayInterval,
+		batchSize:   config.OutboxBatchSize,
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+}
+
+// Start polls the outbox in a new goroutine until Shutdown is called
+func (r *OutboxRelay) Start() {
+	go func() {
+		defer close(r.done)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			select {
+			case <-r.stop:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+
+		timer := time.NewTimer(0)
+		defer timer.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timer.C:
+			}
+
+			dispatched, err := r.RelayBatch(ctx)
+			if err != nil && ctx.Err() == nil {
+				log.Printf("cannot relay outbox messages: %v", err)
+			}
+
+			// A full batch means there is probably more waiting
+			if err == nil && dispatched == r.batchSize {
+				timer.Reset(0)
+			} else {
+				timer.Reset(r.interval)
+			}
+		}
+	}()
+}
+
+// Shutdown stops polling and waits for the batch in flight to finish
+func (r *OutboxRelay) Shutdown() {
+	close(r.stop)
+	<-r.done
+}
+
+// RelayBatch enqueues one batch of pending messages and returns how many were dispatched
+func (r *OutboxRelay) RelayBatch(ctx context.Context) (int, error) {
+	return r.provider.DispatchOutboxMessages(ctx, model.DispatchOutboxMessagesParams{
+		Limit:       r.batchSize,
+		MaxAttempts: outboxMaxAttempts,
+		RetryDelay:  outboxRetryBackoff,
+		Dispatch: func(message *model.OutboxMessage) error {
+			return r.dispatch(ctx, message)
+		},
+	})
+}
+
+// outboxRetryBackoff returns how long to wait after the attempts-th failed attempt
+func outboxRetryBackoff(attempts int) time.Duration {
+	delay := outboxRetryDelay
+	for i := 1; i < attempts && delay < outboxMaxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > outboxMaxRetryDelay {
+		delay = outboxMaxRetryDelay
+	}
+	return delay
+}
+
+func (r *OutboxRelay) dispatch(ctx context.Context, message *model.OutboxMessage) error {
+	opts := []asynq.Option{
+		asynq.TaskID(message.TaskID),
+		asynq.Queue(message.Queue),
+		asynq.MaxRetry(message.MaxRetry),
+		asynq.Retention(outboxTaskRetention),
+	}
+	if message.Timeout > 0 {
+		opts = append(opts, asynq.Timeout(message.Timeout))
+	}
+
+	err := r.distributor.DistributeTask(ctx, message.TaskType, message.Payload, opts...)
+	if errors.Is(err, asynq.ErrTaskIDConflict) {
+		// An earlier attempt enqueued it but could not mark it as dispatched
+		return nil
+	}
+	if err != nil {
+		log.Printf("cannot relay outbox message %d (%s): %v", message.ID, message.TaskType, err)
+		if message.Attempts+1 >= outboxMaxAttempts {
+			log.Printf("giving up on outbox message %d (%s) after %d attempts", message.ID, message.TaskType, outboxMaxAttempts)
+		}
+	}
+	return err
+}
@@ -0,0 +1,147 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yashagw/event-management-api/db/mock"
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/util"
+)
+
+// recordingDistributor only implements DistributeTask, the mock cannot be used
+// here because it imports this package
+type recordingDistributor struct {
+	TaskDistributor
+	distributeTask func(taskType string, payload []byte, opts ...asynq.Option) error
+}
+
+func (d *recordingDistributor) DistributeTask(_ context.Context, taskType string, payload []byte, opts ...asynq.Option) error {
+	return d.distributeTask(taskType, payload, opts...)
+}
+
+func TestNewOutboxMessage(t *testing.T) {
+	message, err := NewOutboxMessage(TaskSendVerifyEmail, &PayloadSendEmailVerify{Email: "jane@example.com", VerifyEmailID: 1},
+		asynq.MaxRetry(3),
+		asynq.Timeout(10*time.Second),
+		asynq.Queue(QueueCritical),
+	)
+	require.NoError(t, err)
+	require.NotEmpty(t, message.TaskID)
+	require.Equal(t, TaskSendVerifyEmail, message.TaskType)
+	require.JSONEq(t, `{"email":"jane@example.com","verify_email_id":1}`, string(message.Payload))
+	require.Equal(t, QueueCritical, message.Queue)
+	require.Equal(t, 3, message.MaxRetry)
+	require.Equal(t, 10*time.Second, message.Timeout)
+
+	other, err := NewOutboxMessage(TaskSendVerifyEmail, &PayloadSendEmailVerify{})
+	require.NoError(t, err)
+	require.NotEqual(t, message.TaskID, other.TaskID)
+	require.Equal(t, QueueDefault, other.Queue)
+	require.Equal(t, defaultMaxRetry, other.MaxRetry)
+	require.Zero(t, other.Timeout)
+
+	withID, err := NewOutboxMessage(TaskSendVerifyEmail, &PayloadSendEmailVerify{}, asynq.TaskID("verify:1"))
+	require.NoError(t, err)
+	require.Equal(t, "verify:1", withID.TaskID)
+
+	_, err = NewOutboxMessage(TaskSendVerifyEmail, &PayloadSendEmailVerify{}, asynq.ProcessIn(time.Minute))
+	require.Error(t, err)
+}
+
+func TestOutboxRelayBatch(t *testing.T) {
+	message := &model.OutboxMessage{
+		ID:       1,
+		TaskID:   "task-id",
+		TaskType: TaskSendVerifyEmail,
+		Payload:  []byte(`{"email":"jane@example.com"}`),
+		Queue:    QueueCritical,
+		MaxRetry: 3,
+		Timeout:  10 * time.Second,
+	}
+
+	testCases := []struct {
+		name       string
+		enqueueErr error
+		checkErr   func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			checkErr: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:       "AlreadyEnqueued",
+			enqueueErr: asynq.ErrTaskIDConflict,
+			checkErr: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:       "EnqueueError",
+			enqueueErr: errors.New("redis is down"),
+			checkErr: func(t *testing.T, err error) {
+				require.Error(t, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			provider := mockdb.NewMockProvider(ctrl)
+			calls := 0
+			distributor := &recordingDistributor{
+				distributeTask: func(taskType string, payload []byte, opts ...asynq.Option) error {
+					calls++
+					require.Equal(t, message.TaskType, taskType)
+					require.Equal(t, message.Payload, payload)
+
+					values := make(map[asynq.OptionType]any)
+					for _, opt := range opts {
+						values[opt.Type()] = opt.Value()
+					}
+					require.Equal(t, message.TaskID, values[asynq.TaskIDOpt])
+					require.Equal(t, message.Queue, values[asynq.QueueOpt])
+					require.Equal(t, message.MaxRetry, values[asynq.MaxRetryOpt])
+					require.Equal(t, message.Timeout, values[asynq.TimeoutOpt])
+					require.Equal(t, outboxTaskRetention, values[asynq.RetentionOpt])
+					return tc.enqueueErr
+				},
+			}
+
+			provider.EXPECT().DispatchOutboxMessages(gomock.Any(), gomock.Any()).Times(1).
+				DoAndReturn(func(_ context.Context, arg model.DispatchOutboxMessagesParams) (int, error) {
+					require.Equal(t, 10, arg.Limit)
+					require.Equal(t, outboxMaxAttempts, arg.MaxAttempts)
+					tc.checkErr(t, arg.Dispatch(message))
+					return 1, nil
+				})
+			relay := NewOutboxRelay(provider, distributor, util.Config{
+				OutboxRelayInterval: time.Second,
+				OutboxBatchSize:     10,
+			})
+			dispatched, err := relay.RelayBatch(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, 1, dispatched)
+			require.Equal(t, 1, calls)
+		})
+	}
+}
+
+func TestOutboxRetryBackoff(t *testing.T) {
+	require.Equal(t, time.Second, outboxRetryBackoff(1))
+	require.Equal(t, 2*time.Second, outboxRetryBackoff(2))
+	require.Equal(t, 8*time.Second, outboxRetryBackoff(4))
+	require.Equal(t, outboxMaxRetryDelay, outboxRetryBackoff(13))
+	require.Equal(t, outboxMaxRetryDelay, outboxRetryBackoff(outboxMaxAttempts))
+}
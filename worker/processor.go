@@ -5,6 +5,8 @@ import (
 
 	"github.com/hibiken/asynq"
 	"github.com/yashagw/event-management-api/db"
+	"github.com/yashagw/event-management-api/mail"
+	"github.com/yashagw/event-management-api/util"
 )
 
 const (
@@ -14,33 +16,85 @@ const (
 
 type TaskProcessor interface {
 	Start() error
+	Shutdown()
 	ProcessTaskSendEmailVerify(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendTicketPurchased(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendTicketCancelled(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendHostRequestDecision(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendEventChanged(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendEventCancelled(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendEventReminder(ctx context.Context, task *asynq.Task) error
+	ProcessTaskExpireHostRequests(ctx context.Context, task *asynq.Task) error
+	ProcessTaskCompleteEvents(ctx context.Context, task *asynq.Task) error
+	ProcessTaskQueueEventReminders(ctx context.Context, task *asynq.Task) error
+	ProcessTaskPurgeExpired(ctx context.Context, task *asynq.Task) error
 }
 
-type RedisTaskProcessor struct {
-	server   *asynq.Server
+// queuePriorities are the weights the processors give to each queue
+var queuePriorities = map[string]int{
+	QueueCritical: 10,
+	QueueDefault:  5,
+}
+
+// taskHandlers implements the Process* methods of TaskProcessor, so that every
+// processor runs tasks with the same handlers
+type taskHandlers struct {
 	provider db.Provider
+	config   util.Config
+	mailer   mail.EmailSender
+}
+
+// serveMux routes every task type to its handler
+func (p *taskHandlers) serveMux() *asynq.ServeMux {
+	mux := asynq.NewServeMux()
+
+	mux.HandleFunc(TaskSendVerifyEmail, p.ProcessTaskSendEmailVerify)
+	mux.HandleFunc(TaskSendTicketPurchased, p.ProcessTaskSendTicketPurchased)
+	mux.HandleFunc(TaskSendTicketCancelled, p.ProcessTaskSendTicketCancelled)
+	mux.HandleFunc(TaskSendHostRequestDecision, p.ProcessTaskSendHostRequestDecision)
+	mux.HandleFunc(TaskSendEventChanged, p.ProcessTaskSendEventChanged)
+	mux.HandleFunc(TaskSendEventCancelled, p.ProcessTaskSendEventCancelled)
+	mux.HandleFunc(TaskSendEventReminder, p.ProcessTaskSendEventReminder)
+
+	mux.HandleFunc(TaskExpireHostRequests, p.ProcessTaskExpireHostRequests)
+	mux.HandleFunc(TaskCompleteEvents, p.ProcessTaskCompleteEvents)
+	mux.HandleFunc(TaskQueueEventReminders, p.ProcessTaskQueueEventReminders)
+	mux.HandleFunc(TaskPurgeExpired, p.ProcessTaskPurgeExpired)
+
+	return mux
 }
 
-func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, provider db.Provider) TaskProcessor {
+type RedisTaskProcessor struct {
+	taskHandlers
+	server *asynq.Server
+}
+
+func NewRedisTaskProcessor(
+	redisOpt asynq.RedisClientOpt,
+	provider db.Provider,
+	config util.Config,
+	mailer mail.EmailSender,
+) TaskProcessor {
 	server := asynq.NewServer(redisOpt,
 		asynq.Config{
-			Queues: map[string]int{
-				QueueCritical: 10,
-				QueueDefault:  5,
-			},
+			Queues: queuePriorities,
 		},
 	)
 	return &RedisTaskProcessor{
-		server:   server,
-		provider: provider,
+		taskHandlers: taskHandlers{
+			provider: provider,
+			config:   config,
+			mailer:   mailer,
+		},
+		server: server,
 	}
 }
 
 func (p *RedisTaskProcessor) Start() error {
-	mux := asynq.NewServeMux()
-
-	mux.HandleFunc(TaskSendVerifyEmail, p.ProcessTaskSendEmailVerify)
+	return p.server.Start(p.serveMux())
+}
 
-	return p.server.Start(mux)
+// Shutdown waits for in-flight tasks to finish and stops the processor
+func (p *RedisTaskProcessor) Shutdown() {
+	p.server.Shutdown()
 }
@@ -0,0 +1,131 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/robfig/cron/v3"
+)
+
+// PeriodicTask is enqueued by the scheduler on a cron schedule and handled by
+// the task processor like any other task
+type PeriodicTask struct {
+	Cronspec string
+	TaskType string
+}
+
+// PeriodicTasks are registered by NewRedisTaskScheduler and NewMemoryTaskScheduler
+var PeriodicTasks = []PeriodicTask{
+	{Cronspec: "0 * * * *", TaskType: TaskExpireHostRequests},
+	{Cronspec: "*/5 * * * *", TaskType: TaskCompleteEvents},
+	{Cronspec: "*/10 * * * *", TaskType: TaskQueueEventReminders},
+	{Cronspec: "30 * * * *", TaskType: TaskPurgeExpired},
+}
+
+type TaskScheduler interface {
+	// Register enqueues a task of the given type whenever cronspec matches
+	Register(cronspec string, taskType string, opts ...asynq.Option) error
+	Start() error
+	Shutdown()
+}
+
+type RedisTaskScheduler struct {
+	scheduler *asynq.Scheduler
+}
+
+func NewRedisTaskScheduler(redisOpt asynq.RedisClientOpt) (TaskScheduler, error) {
+	scheduler := &RedisTaskScheduler{
+		scheduler: asynq.NewScheduler(redisOpt, &asynq.SchedulerOpts{
+			Location: time.UTC,
+		}),
+	}
+
+	for _, task := range PeriodicTasks {
+		err := scheduler.Register(task.Cronspec, task.TaskType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return scheduler, nil
+}
+
+func (s *RedisTaskScheduler) Register(cronspec string, taskType string, opts ...asynq.Option) error {
+	// When several schedulers run, only the first one enqueues each run
+	opts = append([]asynq.Option{
+		asynq.Queue(QueueDefault),
+		asynq.MaxRetry(3),
+		asynq.Unique(time.Minute),
+	}, opts...)
+
+	_, err := s.scheduler.Register(cronspec, asynq.NewTask(taskType, nil), opts...)
+	if err != nil {
+		return fmt.Errorf("could not register %s: %w", taskType, err)
+	}
+	return nil
+}
+
+// Start enqueues the registered tasks in the background until Shutdown is called
+func (s *RedisTaskScheduler) Start() error {
+	return s.scheduler.Start()
+}
+
+func (s *RedisTaskScheduler) Shutdown() {
+	s.scheduler.Shutdown()
+}
+
+// MemoryTaskScheduler runs the schedule in process and enqueues the periodic
+// tasks through a distributor, it is used with the memory broker where there is
+// no redis for asynq to keep the schedule in.
+type MemoryTaskScheduler struct {
+	cron        *cron.Cron
+	distributor TaskDistributor
+}
+
+func NewMemoryTaskScheduler(distributor TaskDistributor) (TaskScheduler, error) {
+	scheduler := &MemoryTaskScheduler{
+		cron:        cron.New(cron.WithLocation(time.UTC)),
+		distributor: distributor,
+	}
+
+	for _, task := range PeriodicTasks {
+		err := scheduler.Register(task.Cronspec, task.TaskType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return scheduler, nil
+}
+
+func (s *MemoryTaskScheduler) Register(cronspec string, taskType string, opts ...asynq.Option) error {
+	opts = append([]asynq.Option{
+		asynq.Queue(QueueDefault),
+		asynq.MaxRetry(3),
+	}, opts...)
+
+	_, err := s.cron.AddFunc(cronspec, func() {
+		err := s.distributor.DistributeTask(context.Background(), taskType, nil, opts...)
+		if err != nil {
+			log.Printf("cannot enqueue periodic task %s: %v", taskType, err)
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("could not register %s: %w", taskType, err)
+	}
+	return nil
+}
+
+// Start enqueues the registered tasks in the background until Shutdown is called
+func (s *MemoryTaskScheduler) Start() error {
+	s.cron.Start()
+	return nil
+}
+
+// Shutdown stops the schedule and waits for a run that is enqueueing to finish
+func (s *MemoryTaskScheduler) Shutdown() {
+	<-s.cron.Stop().Done()
+}
@@ -0,0 +1,34 @@
+package worker
+
+import (
+	"testing"
+
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRedisTaskScheduler(t *testing.T) {
+	// Registering does not need redis, it only validates the cronspecs
+	scheduler, err := NewRedisTaskScheduler(asynq.RedisClientOpt{Addr: "localhost:0"})
+	require.NoError(t, err)
+
+	err = scheduler.Register("*/5 * * * *", TaskCompleteEvents)
+	require.NoError(t, err)
+
+	err = scheduler.Register("not a cronspec", TaskCompleteEvents)
+	require.Error(t, err)
+}
+
+func TestNewMemoryTaskScheduler(t *testing.T) {
+	scheduler, err := NewMemoryTaskScheduler(NewMemoryTaskDistributor(NewMemoryBroker()))
+	require.NoError(t, err)
+
+	err = scheduler.Register("@every 1m", TaskPurgeExpired)
+	require.NoError(t, err)
+
+	err = scheduler.Register("not a cronspec", TaskCompleteEvents)
+	require.Error(t, err)
+
+	require.NoError(t, scheduler.Start())
+	scheduler.Shutdown()
+}
@@ -0,0 +1,121 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/yashagw/event-management-api/db/model"
+)
+
+const (
+	TaskExpireHostRequests  = "task:expire_host_requests"
+	TaskCompleteEvents      = "task:complete_events"
+	TaskQueueEventReminders = "task:queue_event_reminders"
+	TaskPurgeExpired        = "task:purge_expired"
+)
+
+const (
+	// hostRequestExpiry is how long a request may stay pending, and how long a
+	// user has to wait after a rejection before asking again
+	hostRequestExpiry = 30 * 24 * time.Hour
+	// eventReminderBefore is how long before its start an event is reminded
+	eventReminderBefore = 24 * time.Hour
+	// outboxRetention is how long dispatched outbox messages are kept
+	outboxRetention = 7 * 24 * time.Hour
+)
+
+// now is replaced in tests
+var now = time.Now
+
+func (p *taskHandlers) ProcessTaskExpireHostRequests(ctx context.Context, task *asynq.Task) error {
+	before := now().UTC().Add(-hostRequestExpiry)
+	expired, err := p.provider.ExpireHostRequests(ctx, model.ExpireHostRequestsParams{
+		PendingBefore:  before,
+		RejectedBefore: before,
+	})
+	if err != nil {
+		return fmt.Errorf("could not expire host requests: %w", err)
+	}
+
+	log.Printf("expired %d host requests", expired)
+	return nil
+}
+
+func (p *taskHandlers) ProcessTaskCompleteEvents(ctx context.Context, task *asynq.Task) error {
+	completed, err := p.provider.CompleteEvents(ctx, now().UTC())
+	if err != nil {
+		return fmt.Errorf("could not complete events: %w", err)
+	}
+
+	log.Printf("completed %d events", completed)
+	return nil
+}
+
+func (p *taskHandlers) ProcessTaskQueueEventReminders(ctx context.Context, task *asynq.Task) error {
+	current := now().UTC()
+	reminded, err := p.provider.QueueEventReminders(ctx, model.QueueEventRemindersParams{
+		Now:           current,
+		StartsBefore:  current.Add(eventReminderBefore),
+		BuildMessages: buildEventReminders,
+	})
+	if err != nil {
+		return fmt.Errorf("could not queue event reminders: %w", err)
+	}
+
+	log.Printf("queued reminders for %d events", reminded)
+	return nil
+}
+
+// buildEventReminders returns one reminder task per ticket holder, the task id
+// makes sure a holder is reminded of an event only once
+func buildEventReminders(event *model.Event, userIDs []int64) ([]model.CreateOutboxMessageParams, error) {
+	messages := make([]model.CreateOutboxMessageParams, 0, len(userIDs))
+	for _, userID := range userIDs {
+		message, err := NewOutboxMessage(TaskSendEventReminder, &PayloadSendEventReminder{
+			UserID:  userID,
+			EventID: event.ID,
+		},
+			asynq.TaskID(fmt.Sprintf("%s:%d:%d", TaskSendEventReminder, event.ID, userID)),
+			asynq.MaxRetry(10),
+			asynq.Timeout(10*time.Second),
+			asynq.Queue(QueueDefault),
+		)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+
+	return messages, nil
+}
+
+func (p *taskHandlers) ProcessTaskPurgeExpired(ctx context.Context, task *asynq.Task) error {
+	current := now().UTC()
+
+	sessions, err := p.provider.DeleteExpiredSessions(ctx, current)
+	if err != nil {
+		return fmt.Errorf("could not delete expired sessions: %w", err)
+	}
+
+	verifyEmails, err := p.provider.DeleteExpiredVerifyEmails(ctx, current)
+	if err != nil {
+		return fmt.Errorf("could not delete expired verify emails: %w", err)
+	}
+
+	revokedTokens, err := p.provider.DeleteExpiredRevokedTokens(ctx, current)
+	if err != nil {
+		return fmt.Errorf("could not delete expired revoked tokens: %w", err)
+	}
+
+	outbox, err := p.provider.DeleteDispatchedOutboxMessages(ctx, current.Add(-outboxRetention))
+	if err != nil {
+		return fmt.Errorf("could not delete dispatched outbox messages: %w", err)
+	}
+
+	log.Printf("purged %d sessions, %d verify emails, %d revoked tokens and %d outbox messages",
+		sessions, verifyEmails, revokedTokens, outbox)
+	return nil
+}
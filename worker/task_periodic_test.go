@@ -0,0 +1,89 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/yashagw/event-management-api/db/mock"
+	"github.com/yashagw/event-management-api/db/model"
+)
+
+func newTestProcessor(t *testing.T) (*taskHandlers, *mockdb.MockProvider) {
+	ctrl := gomock.NewController(t)
+	provider := mockdb.NewMockProvider(ctrl)
+
+	current := time.Date(2026, time.March, 14, 18, 30, 0, 0, time.UTC)
+	now = func() time.Time { return current }
+	t.Cleanup(func() { now = time.Now })
+
+	return &taskHandlers{provider: provider}, provider
+}
+
+func TestProcessTaskExpireHostRequests(t *testing.T) {
+	processor, provider := newTestProcessor(t)
+
+	before := now().Add(-30 * 24 * time.Hour)
+	provider.EXPECT().ExpireHostRequests(gomock.Any(), model.ExpireHostRequestsParams{
+		PendingBefore:  before,
+		RejectedBefore: before,
+	}).Times(1).Return(int64(2), nil)
+
+	err := processor.ProcessTaskExpireHostRequests(context.Background(), asynq.NewTask(TaskExpireHostRequests, nil))
+	require.NoError(t, err)
+}
+
+func TestProcessTaskCompleteEvents(t *testing.T) {
+	processor, provider := newTestProcessor(t)
+
+	provider.EXPECT().CompleteEvents(gomock.Any(), now()).Times(1).Return(int64(1), nil)
+
+	err := processor.ProcessTaskCompleteEvents(context.Background(), asynq.NewTask(TaskCompleteEvents, nil))
+	require.NoError(t, err)
+}
+
+func TestProcessTaskQueueEventReminders(t *testing.T) {
+	processor, provider := newTestProcessor(t)
+
+	provider.EXPECT().QueueEventReminders(gomock.Any(), gomock.Any()).Times(1).
+		DoAndReturn(func(_ context.Context, arg model.QueueEventRemindersParams) (int64, error) {
+			require.Equal(t, now(), arg.Now)
+			require.Equal(t, now().Add(24*time.Hour), arg.StartsBefore)
+
+			messages, err := arg.BuildMessages(&model.Event{ID: 3}, []int64{1, 2})
+			require.NoError(t, err)
+			require.Len(t, messages, 2)
+
+			for i, userID := range []int64{1, 2} {
+				require.Equal(t, TaskSendEventReminder, messages[i].TaskType)
+
+				var payload PayloadSendEventReminder
+				require.NoError(t, json.Unmarshal(messages[i].Payload, &payload))
+				require.Equal(t, PayloadSendEventReminder{UserID: userID, EventID: 3}, payload)
+			}
+
+			// Task ids are stable so a holder is never reminded twice
+			require.Equal(t, "task:send_event_reminder:3:1", messages[0].TaskID)
+			require.Equal(t, "task:send_event_reminder:3:2", messages[1].TaskID)
+			return 1, nil
+		})
+
+	err := processor.ProcessTaskQueueEventReminders(context.Background(), asynq.NewTask(TaskQueueEventReminders, nil))
+	require.NoError(t, err)
+}
+
+func TestProcessTaskPurgeExpired(t *testing.T) {
+	processor, provider := newTestProcessor(t)
+
+	provider.EXPECT().DeleteExpiredSessions(gomock.Any(), now()).Times(1).Return(int64(1), nil)
+	provider.EXPECT().DeleteExpiredVerifyEmails(gomock.Any(), now()).Times(1).Return(int64(1), nil)
+	provider.EXPECT().DeleteExpiredRevokedTokens(gomock.Any(), now()).Times(1).Return(int64(1), nil)
+	provider.EXPECT().DeleteDispatchedOutboxMessages(gomock.Any(), now().Add(-7*24*time.Hour)).Times(1).Return(int64(1), nil)
+
+	err := processor.ProcessTaskPurgeExpired(context.Background(), asynq.NewTask(TaskPurgeExpired, nil))
+	require.NoError(t, err)
+}
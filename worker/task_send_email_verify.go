@@ -5,32 +5,29 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"time"
 
 	"github.com/hibiken/asynq"
+	"github.com/yashagw/event-management-api/db/model"
 )
 
 const TaskSendVerifyEmail = "task:send_verify_email"
 
 type PayloadSendEmailVerify struct {
 	Email string `json:"email"`
+	// VerifyEmailID is the code to send, it is created before the task so
+	// every retry sends the same code
+	VerifyEmailID int64 `json:"verify_email_id"`
+	// NewUser sends the code as the welcome email of a new user
+	NewUser bool `json:"new_user,omitempty"`
 }
 
-func (d *RedisTaskDistributor) DistributeTaskSendEmailVerify(context context.Context, payload *PayloadSendEmailVerify, opts ...asynq.Option) error {
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return fmt.Errorf("could not marshal payload: %w", err)
-	}
-
-	task := asynq.NewTask(TaskSendVerifyEmail, jsonPayload, opts...)
-	_, err = d.client.EnqueueContext(context, task)
-	if err != nil {
-		return fmt.Errorf("could not enqueue task: %w", err)
-	}
-	// TODO: log task enqueued
-	return nil
+func (d *payloadDistributor) DistributeTaskSendEmailVerify(context context.Context, payload *PayloadSendEmailVerify, opts ...asynq.Option) error {
+	return d.enqueue(context, TaskSendVerifyEmail, payload, opts...)
 }
 
-func (p *RedisTaskProcessor) ProcessTaskSendEmailVerify(ctx context.Context, task *asynq.Task) error {
+func (p *taskHandlers) ProcessTaskSendEmailVerify(ctx context.Context, task *asynq.Task) error {
 	var payload PayloadSendEmailVerify
 	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
 		return fmt.Errorf("could not unmarshal payload: %w", err)
@@ -44,8 +41,50 @@ func (p *RedisTaskProcessor) ProcessTaskSendEmailVerify(ctx context.Context, tas
 		return fmt.Errorf("could not get user by email: %w", err)
 	}
 
-	fmt.Println("sending email to", user.Email)
-	// TODO: send email
+	if user.IsEmailVerified {
+		return nil
+	}
+
+	verifyEmail, err := p.provider.GetVerifyEmail(ctx, payload.VerifyEmailID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		return fmt.Errorf("could not get verify email: %w", err)
+	}
+
+	// A code that can no longer be used is not worth sending
+	if verifyEmail.IsUsed || time.Now().After(verifyEmail.ExpiredAt) {
+		return nil
+	}
+
+	subject := "Verify your email"
+	if payload.NewUser {
+		subject = "Welcome to Event Management"
+	}
+
+	email, err := RenderEmail(EmailTemplateVerifyEmail, EmailData{
+		Subject:   subject,
+		User:      user,
+		VerifyURL: VerifyEmailURL(p.config.VerifyEmailURL, verifyEmail),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = p.mailer.SendEmail(email)
+	if err != nil {
+		return fmt.Errorf("could not send verify email: %w", err)
+	}
 
 	return nil
 }
+
+// VerifyEmailURL builds the link a user follows to verify their email
+func VerifyEmailURL(baseURL string, verifyEmail *model.VerifyEmail) string {
+	query := url.Values{}
+	query.Set("email_id", fmt.Sprint(verifyEmail.ID))
+	query.Set("secret_code", verifyEmail.SecretCode)
+
+	return baseURL + "?" + query.Encode()
+}
@@ -0,0 +1,144 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/yashagw/event-management-api/db/model"
+)
+
+const (
+	TaskSendEventChanged   = "task:send_event_changed"
+	TaskSendEventCancelled = "task:send_event_cancelled"
+	TaskSendEventReminder  = "task:send_event_reminder"
+)
+
+// PayloadSendEventChanged tells one ticket holder that an event was updated,
+// one task is distributed per holder.
+type PayloadSendEventChanged struct {
+	UserID  int64 `json:"user_id"`
+	EventID int64 `json:"event_id"`
+}
+
+// PayloadSendEventCancelled tells one ticket holder that an event was cancelled,
+// one task is distributed per holder.
+type PayloadSendEventCancelled struct {
+	UserID  int64 `json:"user_id"`
+	EventID int64 `json:"event_id"`
+}
+
+// PayloadSendEventReminder reminds one ticket holder that an event starts soon,
+// one task is distributed per holder.
+type PayloadSendEventReminder struct {
+	UserID  int64 `json:"user_id"`
+	EventID int64 `json:"event_id"`
+}
+
+func (d *payloadDistributor) DistributeTaskSendEventChanged(context context.Context, payload *PayloadSendEventChanged, opts ...asynq.Option) error {
+	return d.enqueue(context, TaskSendEventChanged, payload, opts...)
+}
+
+func (d *payloadDistributor) DistributeTaskSendEventCancelled(context context.Context, payload *PayloadSendEventCancelled, opts ...asynq.Option) error {
+	return d.enqueue(context, TaskSendEventCancelled, payload, opts...)
+}
+
+func (d *payloadDistributor) DistributeTaskSendEventReminder(context context.Context, payload *PayloadSendEventReminder, opts ...asynq.Option) error {
+	return d.enqueue(context, TaskSendEventReminder, payload, opts...)
+}
+
+// NewEventChangedMessages returns one event changed task per ticket holder,
+// ready to be written to the outbox with the update of the event
+func NewEventChangedMessages(event *model.Event, userIDs []int64) ([]model.CreateOutboxMessageParams, error) {
+	messages := make([]model.CreateOutboxMessageParams, 0, len(userIDs))
+	for _, userID := range userIDs {
+		message, err := NewOutboxMessage(TaskSendEventChanged, &PayloadSendEventChanged{
+			UserID:  userID,
+			EventID: event.ID,
+		},
+			asynq.MaxRetry(10),
+			asynq.Timeout(10*time.Second),
+			asynq.Queue(QueueDefault),
+		)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+
+	return messages, nil
+}
+
+// NewEventCancelledMessages returns one event cancelled task per ticket holder,
+// ready to be written to the outbox with the cancellation of the event. The
+// task id makes sure a holder is told only once.
+func NewEventCancelledMessages(event *model.Event, userIDs []int64) ([]model.CreateOutboxMessageParams, error) {
+	messages := make([]model.CreateOutboxMessageParams, 0, len(userIDs))
+	for _, userID := range userIDs {
+		message, err := NewOutboxMessage(TaskSendEventCancelled, &PayloadSendEventCancelled{
+			UserID:  userID,
+			EventID: event.ID,
+		},
+			asynq.TaskID(fmt.Sprintf("%s:%d:%d", TaskSendEventCancelled, event.ID, userID)),
+			asynq.MaxRetry(10),
+			asynq.Timeout(10*time.Second),
+			asynq.Queue(QueueDefault),
+		)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+
+	return messages, nil
+}
+
+func (p *taskHandlers) ProcessTaskSendEventChanged(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendEventChanged
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("could not unmarshal payload: %w", err)
+	}
+
+	return p.sendEventEmail(ctx, EmailTemplateEventChanged, "Event updated", payload.UserID, payload.EventID)
+}
+
+func (p *taskHandlers) ProcessTaskSendEventCancelled(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendEventCancelled
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("could not unmarshal payload: %w", err)
+	}
+
+	return p.sendEventEmail(ctx, EmailTemplateEventCancelled, "Event cancelled", payload.UserID, payload.EventID)
+}
+
+func (p *taskHandlers) ProcessTaskSendEventReminder(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendEventReminder
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("could not unmarshal payload: %w", err)
+	}
+
+	return p.sendEventEmail(ctx, EmailTemplateEventReminder, "Starting soon", payload.UserID, payload.EventID)
+}
+
+// sendEventEmail loads the ticket holder and the event and sends them the named template
+func (p *taskHandlers) sendEventEmail(ctx context.Context, name EmailTemplate, subject string, userID, eventID int64) error {
+	user, err := p.provider.GetUserByID(ctx, userID)
+	if err != nil {
+		return notFoundSkipRetry(fmt.Errorf("could not get user %d: %w", userID, err))
+	}
+
+	event, err := p.provider.GetEvent(ctx, model.GetEventParams{
+		EventID: eventID,
+	})
+	if err != nil {
+		return notFoundSkipRetry(fmt.Errorf("could not get event %d: %w", eventID, err))
+	}
+
+	return p.sendTemplatedEmail(name, EmailData{
+		Subject: fmt.Sprintf("%s: %s", subject, event.Name),
+		User:    user,
+		Event:   event,
+	})
+}
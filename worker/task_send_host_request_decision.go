@@ -0,0 +1,44 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+)
+
+const TaskSendHostRequestDecision = "task:send_host_request_decision"
+
+// PayloadSendHostRequestDecision tells a user whether a moderator approved their host request
+type PayloadSendHostRequestDecision struct {
+	UserID    int64 `json:"user_id"`
+	RequestID int64 `json:"request_id"`
+	Approved  bool  `json:"approved"`
+}
+
+func (d *payloadDistributor) DistributeTaskSendHostRequestDecision(context context.Context, payload *PayloadSendHostRequestDecision, opts ...asynq.Option) error {
+	return d.enqueue(context, TaskSendHostRequestDecision, payload, opts...)
+}
+
+func (p *taskHandlers) ProcessTaskSendHostRequestDecision(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendHostRequestDecision
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("could not unmarshal payload: %w", err)
+	}
+
+	user, err := p.provider.GetUserByID(ctx, payload.UserID)
+	if err != nil {
+		return notFoundSkipRetry(fmt.Errorf("could not get user %d: %w", payload.UserID, err))
+	}
+
+	name, subject := EmailTemplateHostRequestRejected, "Your host request was rejected"
+	if payload.Approved {
+		name, subject = EmailTemplateHostRequestApproved, "You are now a host"
+	}
+
+	return p.sendTemplatedEmail(name, EmailData{
+		Subject: subject,
+		User:    user,
+	})
+}
@@ -0,0 +1,108 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/yashagw/event-management-api/db/model"
+)
+
+const (
+	TaskSendTicketPurchased = "task:send_ticket_purchased"
+	TaskSendTicketCancelled = "task:send_ticket_cancelled"
+)
+
+// PayloadSendTicketPurchased confirms a ticket purchase to its buyer
+type PayloadSendTicketPurchased struct {
+	UserID   int64 `json:"user_id"`
+	TicketID int64 `json:"ticket_id"`
+}
+
+// PayloadSendTicketCancelled tells a buyer that their ticket was cancelled
+type PayloadSendTicketCancelled struct {
+	UserID   int64 `json:"user_id"`
+	TicketID int64 `json:"ticket_id"`
+}
+
+func (d *payloadDistributor) DistributeTaskSendTicketPurchased(context context.Context, payload *PayloadSendTicketPurchased, opts ...asynq.Option) error {
+	return d.enqueue(context, TaskSendTicketPurchased, payload, opts...)
+}
+
+func (d *payloadDistributor) DistributeTaskSendTicketCancelled(context context.Context, payload *PayloadSendTicketCancelled, opts ...asynq.Option) error {
+	return d.enqueue(context, TaskSendTicketCancelled, payload, opts...)
+}
+
+func (p *taskHandlers) ProcessTaskSendTicketPurchased(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendTicketPurchased
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("could not unmarshal payload: %w", err)
+	}
+
+	return p.sendTicketEmail(ctx, EmailTemplateTicketPurchased, "Your tickets are confirmed", payload.UserID, payload.TicketID)
+}
+
+func (p *taskHandlers) ProcessTaskSendTicketCancelled(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendTicketCancelled
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("could not unmarshal payload: %w", err)
+	}
+
+	return p.sendTicketEmail(ctx, EmailTemplateTicketCancelled, "Your tickets have been cancelled", payload.UserID, payload.TicketID)
+}
+
+// sendTicketEmail loads the buyer, the ticket and its event and sends them the named template
+func (p *taskHandlers) sendTicketEmail(ctx context.Context, name EmailTemplate, subject string, userID, ticketID int64) error {
+	user, err := p.provider.GetUserByID(ctx, userID)
+	if err != nil {
+		return notFoundSkipRetry(fmt.Errorf("could not get user %d: %w", userID, err))
+	}
+
+	ticket, err := p.provider.GetTicket(ctx, model.GetTicketParams{
+		TicketID: ticketID,
+		UserID:   userID,
+	})
+	if err != nil {
+		return notFoundSkipRetry(fmt.Errorf("could not get ticket %d: %w", ticketID, err))
+	}
+
+	event, err := p.provider.GetEvent(ctx, model.GetEventParams{
+		EventID: ticket.EventID,
+	})
+	if err != nil {
+		return notFoundSkipRetry(fmt.Errorf("could not get event %d: %w", ticket.EventID, err))
+	}
+
+	return p.sendTemplatedEmail(name, EmailData{
+		Subject: fmt.Sprintf("%s: %s", subject, event.Name),
+		User:    user,
+		Event:   event,
+		Ticket:  ticket,
+	})
+}
+
+// sendTemplatedEmail renders the named template and sends it to data.User
+func (p *taskHandlers) sendTemplatedEmail(name EmailTemplate, data EmailData) error {
+	email, err := RenderEmail(name, data)
+	if err != nil {
+		return err
+	}
+
+	err = p.mailer.SendEmail(email)
+	if err != nil {
+		return fmt.Errorf("could not send %s email: %w", name, err)
+	}
+
+	return nil
+}
+
+// notFoundSkipRetry stops asynq from retrying a task whose records no longer exist
+func notFoundSkipRetry(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%v: %w", err, asynq.SkipRetry)
+	}
+	return err
+}
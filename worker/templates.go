@@ -0,0 +1,114 @@
+package worker
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/yashagw/event-management-api/db/model"
+	"github.com/yashagw/event-management-api/mail"
+)
+
+// EmailTemplate names a pair of templates/<name>.html and templates/<name>.txt files
+type EmailTemplate string
+
+const (
+	EmailTemplateVerifyEmail         EmailTemplate = "verify_email"
+	EmailTemplateTicketPurchased     EmailTemplate = "ticket_purchased"
+	EmailTemplateTicketCancelled     EmailTemplate = "ticket_cancelled"
+	EmailTemplateHostRequestApproved EmailTemplate = "host_request_approved"
+	EmailTemplateHostRequestRejected EmailTemplate = "host_request_rejected"
+	EmailTemplateEventChanged        EmailTemplate = "event_changed"
+	EmailTemplateEventCancelled      EmailTemplate = "event_cancelled"
+	EmailTemplateEventReminder       EmailTemplate = "event_reminder"
+)
+
+// EmailTemplates lists every template that is parsed on startup
+var EmailTemplates = []EmailTemplate{
+	EmailTemplateVerifyEmail,
+	EmailTemplateTicketPurchased,
+	EmailTemplateTicketCancelled,
+	EmailTemplateHostRequestApproved,
+	EmailTemplateHostRequestRejected,
+	EmailTemplateEventChanged,
+	EmailTemplateEventCancelled,
+	EmailTemplateEventReminder,
+}
+
+// EmailData is what the templates are executed with, fields that an email
+// does not need are left nil.
+type EmailData struct {
+	Subject   string
+	User      *model.User
+	Event     *model.Event
+	Ticket    *model.Ticket
+	VerifyURL string
+}
+
+//go:embed templates
+var templateFS embed.FS
+
+var templateFuncs = map[string]any{
+	"formatTime": func(t time.Time) string {
+		return t.Format("Mon, 02 Jan 2006 15:04 MST")
+	},
+}
+
+type emailTemplate struct {
+	html *htmltemplate.Template
+	text *texttemplate.Template
+}
+
+var emailTemplates = mustParseEmailTemplates()
+
+// mustParseEmailTemplates parses every html template into a copy of the shared
+// layout, so a broken template fails the binary on startup instead of a task.
+func mustParseEmailTemplates() map[EmailTemplate]emailTemplate {
+	layout := htmltemplate.Must(htmltemplate.New("layout").Funcs(templateFuncs).ParseFS(
+		templateFS, "templates/layout.html", "templates/event_details.html"))
+
+	templates := make(map[EmailTemplate]emailTemplate, len(EmailTemplates))
+	for _, name := range EmailTemplates {
+		html := htmltemplate.Must(htmltemplate.Must(layout.Clone()).ParseFS(
+			templateFS, fmt.Sprintf("templates/%s.html", name)))
+		text := texttemplate.Must(texttemplate.New(string(name)+".txt").Funcs(templateFuncs).ParseFS(
+			templateFS, "templates/event_details.txt", fmt.Sprintf("templates/%s.txt", name)))
+
+		templates[name] = emailTemplate{html: html, text: text}
+	}
+
+	return templates
+}
+
+// RenderEmail executes both bodies of the named template and returns an email
+// addressed to the user in data.
+func RenderEmail(name EmailTemplate, data EmailData) (*mail.Email, error) {
+	tmpl, ok := emailTemplates[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown email template %q", name)
+	}
+
+	var html bytes.Buffer
+	if err := tmpl.html.ExecuteTemplate(&html, "layout", data); err != nil {
+		return nil, fmt.Errorf("could not render html body of %s: %w", name, err)
+	}
+
+	var text bytes.Buffer
+	if err := tmpl.text.Execute(&text, data); err != nil {
+		return nil, fmt.Errorf("could not render text body of %s: %w", name, err)
+	}
+
+	email := &mail.Email{
+		Subject:  data.Subject,
+		TextBody: text.String(),
+		HTMLBody: html.String(),
+	}
+	if data.User != nil {
+		email.To = []string{data.User.Email}
+	}
+
+	return email, nil
+}
@@ -0,0 +1,87 @@
+package worker
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yashagw/event-management-api/db/model"
+)
+
+// TestRenderEmail renders every template with sample data. Set EMAIL_PREVIEW_DIR
+// to also write the rendered bodies there, see `make preview_emails`.
+func TestRenderEmail(t *testing.T) {
+	start := time.Date(2026, time.March, 14, 18, 30, 0, 0, time.UTC)
+	data := EmailData{
+		Subject: "Preview",
+		User: &model.User{
+			ID:    1,
+			Name:  "Jane <Doe>",
+			Email: "jane@example.com",
+		},
+		Event: &model.Event{
+			ID:           1,
+			Name:         "Rock & Roll Night",
+			Description:  "An evening of live music.",
+			Location:     "Bengaluru",
+			TotalTickets: 100,
+			LeftTickets:  42,
+			StartDate:    start,
+			EndDate:      start.Add(4 * time.Hour),
+		},
+		Ticket: &model.Ticket{
+			ID:       7,
+			UserID:   1,
+			EventID:  1,
+			Quantity: 2,
+		},
+		VerifyURL: "http://localhost:8080/users/verify_email?email_id=1&secret_code=abc",
+	}
+
+	previewDir := os.Getenv("EMAIL_PREVIEW_DIR")
+	if previewDir != "" {
+		require.NoError(t, os.MkdirAll(previewDir, 0o755))
+	}
+
+	for _, name := range EmailTemplates {
+		t.Run(string(name), func(t *testing.T) {
+			email, err := RenderEmail(name, data)
+			require.NoError(t, err)
+			require.Equal(t, []string{data.User.Email}, email.To)
+			require.Equal(t, data.Subject, email.Subject)
+
+			// html is escaped, the text body is sent as is
+			require.Contains(t, email.HTMLBody, "Jane &lt;Doe&gt;")
+			require.Contains(t, email.TextBody, "Jane <Doe>")
+			require.NotContains(t, email.TextBody, "&lt;")
+
+			if previewDir != "" {
+				base := filepath.Join(previewDir, string(name))
+				require.NoError(t, os.WriteFile(base+".html", []byte(email.HTMLBody), 0o644))
+				require.NoError(t, os.WriteFile(base+".txt", []byte(email.TextBody), 0o644))
+			}
+		})
+	}
+
+	_, err := RenderEmail("unknown", data)
+	require.Error(t, err)
+}
+
+func TestRenderEmailEventDetails(t *testing.T) {
+	event := &model.Event{
+		Name:      "Rock & Roll Night",
+		Location:  "Bengaluru",
+		StartDate: time.Date(2026, time.March, 14, 18, 30, 0, 0, time.UTC),
+	}
+
+	email, err := RenderEmail(EmailTemplateEventCancelled, EmailData{
+		User:  &model.User{Name: "Jane"},
+		Event: event,
+	})
+	require.NoError(t, err)
+	require.Contains(t, email.HTMLBody, "Rock &amp; Roll Night")
+	require.Contains(t, email.TextBody, "Rock & Roll Night")
+	require.Contains(t, email.TextBody, "Sat, 14 Mar 2026 18:30 UTC")
+}